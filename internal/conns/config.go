@@ -27,41 +27,42 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     []awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	TokenBucketRateLimiterCapacity int
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                          string
+	AllowedAccountIds                  []string
+	AssumeRole                         []awsbase.AssumeRole
+	AssumeRoleWithWebIdentity          *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                     string
+	DefaultTagsConfig                  *tftags.DefaultConfig
+	EC2MetadataServiceEnableState      imds.ClientEnableState
+	EC2MetadataServiceEndpoint         string
+	EC2MetadataServiceEndpointMode     string
+	Endpoints                          map[string]string
+	ForbiddenAccountIds                []string
+	HTTPProxy                          *string
+	HTTPSProxy                         *string
+	IgnoreTagsConfig                   *tftags.IgnoreConfig
+	Insecure                           bool
+	MaxRetries                         int
+	NoProxy                            string
+	Profile                            string
+	Region                             string
+	RetryMode                          aws.RetryMode
+	S3UsePathStyle                     bool
+	S3USEast1RegionalEndpoint          string
+	SecretKey                          string
+	SharedConfigFiles                  []string
+	SharedCredentialsFiles             []string
+	SkipCredsValidation                bool
+	SkipRegionValidation               bool
+	SkipRequestingAccountId            bool
+	STSRegion                          string
+	SuppressDebugLog                   bool
+	TerraformVersion                   string
+	Token                              string
+	TokenBucketRateLimiterCapacity     int
+	UseDualStackEndpoint               bool
+	UseFIPSEndpoint                    bool
+	ValidatePoliciesWithAccessAnalyzer bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -223,6 +224,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.validatePoliciesWithAccessAnalyzer = c.ValidatePoliciesWithAccessAnalyzer
 
 	return client, diags
 }
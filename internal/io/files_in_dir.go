@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// FilesInDir returns the slash-separated paths, relative to dir, of the regular files in the directory tree rooted at dir.
+// If includes is not empty only files matching at least one of its patterns are returned, and files matching any of the
+// excludes patterns are never returned. Patterns are matched using MatchGlob. The paths are returned in lexical order.
+// Symbolic links to regular files are followed, symbolic links to directories are not.
+func FilesInDir(dir string, includes, excludes []string) ([]string, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range slices.Concat(includes, excludes) {
+		if _, err := MatchGlob(pattern, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	var files []string
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			fi, err := os.Stat(p)
+			if err != nil {
+				return err
+			}
+
+			if !fi.Mode().IsRegular() {
+				return nil
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if !IsIncluded(rel, includes, excludes) {
+			return nil
+		}
+
+		files = append(files, rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(files)
+
+	return files, nil
+}
+
+// IsIncluded reports whether the slash-separated name is selected by the includes and excludes patterns as in FilesInDir.
+// Invalid patterns never match.
+func IsIncluded(name string, includes, excludes []string) bool {
+	if len(includes) > 0 && !matchAnyGlob(includes, name) {
+		return false
+	}
+
+	return !matchAnyGlob(excludes, name)
+}
+
+// MatchGlob reports whether the slash-separated name matches the shell pattern.
+// In addition to the syntax supported by path.Match, a "**" path element matches zero or more path elements.
+// A pattern without a slash, such as "*.pyc", matches against the name's final element.
+func MatchGlob(pattern, name string) (bool, error) {
+	elements := strings.Split(pattern, "/")
+
+	// Validate the whole pattern up front as matching stops at the first mismatch.
+	for _, v := range elements {
+		if _, err := path.Match(v, ""); err != nil {
+			return false, err
+		}
+	}
+
+	if len(elements) == 1 {
+		return path.Match(pattern, path.Base(name))
+	}
+
+	return matchGlobElements(elements, strings.Split(name, "/"))
+}
+
+func matchGlobElements(patterns, names []string) (bool, error) {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			// Collapse consecutive "**" elements and try each possible number of matched elements.
+			for len(patterns) > 0 && patterns[0] == "**" {
+				patterns = patterns[1:]
+			}
+
+			if len(patterns) == 0 {
+				return true, nil
+			}
+
+			for i := range len(names) + 1 {
+				if ok, err := matchGlobElements(patterns, names[i:]); ok || err != nil {
+					return ok, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(names) == 0 {
+			return false, nil
+		}
+
+		ok, err := path.Match(patterns[0], names[0])
+		if !ok || err != nil {
+			return false, err
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0, nil
+}
+
+func matchAnyGlob(patterns []string, name string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := MatchGlob(pattern, name)
+		return ok
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern   string
+		name      string
+		want      bool
+		wantError bool
+	}{
+		{pattern: "*.py", name: "main.py", want: true},
+		{pattern: "*.py", name: "pkg/util.py", want: true},
+		{pattern: "*.py", name: "main.pyc"},
+		{pattern: "pkg/*.py", name: "pkg/util.py", want: true},
+		{pattern: "pkg/*.py", name: "pkg/sub/util.py"},
+		{pattern: "pkg/**/*.py", name: "pkg/util.py", want: true},
+		{pattern: "pkg/**/*.py", name: "pkg/sub/deeper/util.py", want: true},
+		{pattern: "tests/**", name: "tests/unit/test_main.py", want: true},
+		{pattern: "tests/**", name: "src/tests/test_main.py"},
+		{pattern: "**/__pycache__/**", name: "__pycache__/main.cpython-312.pyc", want: true},
+		{pattern: "**/__pycache__/**", name: "pkg/__pycache__/util.cpython-312.pyc", want: true},
+		{pattern: "**/**/*.js", name: "index.js", want: true},
+		{pattern: "pkg/[", name: "pkg/x", wantError: true},
+		{pattern: "[", name: "x", wantError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern+" "+testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MatchGlob(testCase.pattern, testCase.name)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("MatchGlob(%q, %q) err %t, want %t", testCase.pattern, testCase.name, got, want)
+			}
+			if got != testCase.want {
+				t.Errorf("MatchGlob(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFilesInDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, v := range []string{
+		"main.py",
+		"requirements.txt",
+		"pkg/__init__.py",
+		"pkg/util.py",
+		"pkg/__pycache__/util.cpython-312.pyc",
+		"tests/test_main.py",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(v))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(v), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		includes []string
+		excludes []string
+		want     []string
+	}{
+		"all": {
+			want: []string{
+				"main.py",
+				"pkg/__init__.py",
+				"pkg/__pycache__/util.cpython-312.pyc",
+				"pkg/util.py",
+				"requirements.txt",
+				"tests/test_main.py",
+			},
+		},
+		"includes": {
+			includes: []string{"*.py"},
+			want: []string{
+				"main.py",
+				"pkg/__init__.py",
+				"pkg/util.py",
+				"tests/test_main.py",
+			},
+		},
+		"includes and excludes": {
+			includes: []string{"*.py", "requirements.txt"},
+			excludes: []string{"tests/**", "**/__pycache__/**"},
+			want: []string{
+				"main.py",
+				"pkg/__init__.py",
+				"pkg/util.py",
+				"requirements.txt",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := FilesInDir(dir, testCase.includes, testCase.excludes)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -168,6 +168,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"validate_policies_with_access_analyzer": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate policy documents with IAM Access Analyzer during plan",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -5,9 +5,10 @@ package accessanalyzer
 
 // Exports for use in tests only.
 var (
-	ArchiveRuleParseResourceID  = archiveRuleParseResourceID
-	FindAnalyzerByName          = findAnalyzerByName
-	FindArchiveRuleByTwoPartKey = findArchiveRuleByTwoPartKey
+	ArchiveRuleParseResourceID   = archiveRuleParseResourceID
+	FindAnalyzerByName           = findAnalyzerByName
+	FindArchiveRuleByTwoPartKey  = findArchiveRuleByTwoPartKey
+	PolicyValidationFindingError = policyValidationFindingError
 
 	ResourceAnalyzer    = resourceAnalyzer
 	ResourceArchiveRule = resourceArchiveRule
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// PolicyValidationCustomizeDiff returns a CustomizeDiffFunc that validates the policy document in the attribute with the specified key
+// using IAM Access Analyzer when the provider's validate_policies_with_access_analyzer setting is enabled.
+// resourceType is optional and only applies to resource policies.
+func PolicyValidationCustomizeDiff(key string, policyType types.PolicyType, resourceType types.ValidatePolicyResourceType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) || !d.HasChange(key) {
+			return nil
+		}
+
+		// A path error is reported as a diagnostic on the attribute, with each finding on its own line.
+		if err := ValidatePolicyDocument(ctx, meta.(*conns.AWSClient), key, d.Get(key).(string), policyType, resourceType); err != nil {
+			return cty.GetAttrPath(key).NewError(err)
+		}
+
+		return nil
+	}
+}
+
+// ValidatePolicyDocument validates a policy document using IAM Access Analyzer when the provider's
+// validate_policies_with_access_analyzer setting is enabled.
+// ERROR and SECURITY_WARNING findings are returned as errors prefixed with the attribute key and the finding's location within the document.
+func ValidatePolicyDocument(ctx context.Context, client *conns.AWSClient, key, document string, policyType types.PolicyType, resourceType types.ValidatePolicyResourceType) error {
+	if !client.ValidatePoliciesWithAccessAnalyzer(ctx) || document == "" {
+		return nil
+	}
+
+	conn := client.AccessAnalyzerClient(ctx)
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(document),
+		PolicyType:     policyType,
+	}
+
+	if resourceType != "" {
+		input.ValidatePolicyResourceType = resourceType
+	}
+
+	findings, err := findPolicyValidationFindings(ctx, conn, input, types.ValidatePolicyFindingTypeError, types.ValidatePolicyFindingTypeSecurityWarning)
+
+	if err != nil {
+		return fmt.Errorf("%s: validating policy with IAM Access Analyzer: %w", key, err)
+	}
+
+	var errs []error
+
+	for _, v := range findings {
+		errs = append(errs, policyValidationFindingError(key, v))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput, findingTypes ...types.ValidatePolicyFindingType) ([]types.ValidatePolicyFinding, error) {
+	var output []types.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, tfslices.Filter(page.Findings, func(v types.ValidatePolicyFinding) bool {
+			return len(findingTypes) == 0 || slices.Contains(findingTypes, v.FindingType)
+		})...)
+	}
+
+	return output, nil
+}
+
+// policyValidationFindingError returns an error describing the finding.
+// The error message starts with the attribute key followed by each of the finding's locations, e.g. "policy: Statement[0].Action[1]".
+func policyValidationFindingError(key string, finding types.ValidatePolicyFinding) error {
+	var locations []string
+
+	for _, v := range finding.Locations {
+		if path := policyValidationLocationPath(v); path != "" {
+			locations = append(locations, path)
+		}
+	}
+
+	prefix := key
+	if len(locations) > 0 {
+		prefix = fmt.Sprintf("%s: %s", key, strings.Join(locations, ", "))
+	}
+
+	return fmt.Errorf("%s: %s %s: %s", prefix, finding.FindingType, aws.ToString(finding.IssueCode), aws.ToString(finding.FindingDetails))
+}
+
+// policyValidationLocationPath returns the path to a finding's location within a policy document,
+// falling back to the location's span if it has no path.
+func policyValidationLocationPath(location types.Location) string {
+	var sb strings.Builder
+
+	for _, v := range location.Path {
+		switch v := v.(type) {
+		case *types.PathElementMemberIndex:
+			sb.WriteString("[" + strconv.Itoa(int(v.Value)) + "]")
+		case *types.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *types.PathElementMemberValue:
+			sb.WriteString("[" + strconv.Quote(v.Value) + "]")
+		case *types.PathElementMemberSubstring:
+			start := int(aws.ToInt32(v.Value.Start))
+			sb.WriteString("[" + strconv.Itoa(start) + ":" + strconv.Itoa(start+int(aws.ToInt32(v.Value.Length))) + "]")
+		}
+	}
+
+	if sb.Len() == 0 && location.Span != nil && location.Span.Start != nil {
+		start := location.Span.Start
+		fmt.Fprintf(&sb, "line %d, column %d", aws.ToInt32(start.Line), aws.ToInt32(start.Column))
+	}
+
+	return sb.String()
+}
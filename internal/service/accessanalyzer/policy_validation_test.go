@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyValidationFindingError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		finding  types.ValidatePolicyFinding
+		expected string
+	}{
+		"no location": {
+			finding: types.ValidatePolicyFinding{
+				FindingDetails: aws.String("The policy is not valid JSON."),
+				FindingType:    types.ValidatePolicyFindingTypeError,
+				IssueCode:      aws.String("JSON_SYNTAX_ERROR"),
+			},
+			expected: "policy: ERROR JSON_SYNTAX_ERROR: The policy is not valid JSON.",
+		},
+		"path": {
+			finding: types.ValidatePolicyFinding{
+				FindingDetails: aws.String("The action s3:GetObjekt does not exist."),
+				FindingType:    types.ValidatePolicyFindingTypeError,
+				IssueCode:      aws.String("INVALID_ACTION"),
+				Locations: []types.Location{
+					{
+						Path: []types.PathElement{
+							&types.PathElementMemberKey{Value: "Statement"},
+							&types.PathElementMemberIndex{Value: 0},
+							&types.PathElementMemberKey{Value: "Action"},
+							&types.PathElementMemberIndex{Value: 1},
+						},
+					},
+				},
+			},
+			expected: "policy: Statement[0].Action[1]: ERROR INVALID_ACTION: The action s3:GetObjekt does not exist.",
+		},
+		"multiple locations": {
+			finding: types.ValidatePolicyFinding{
+				FindingDetails: aws.String("Using PassRole with a wildcard in the resource can be overly permissive."),
+				FindingType:    types.ValidatePolicyFindingTypeSecurityWarning,
+				IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+				Locations: []types.Location{
+					{
+						Path: []types.PathElement{
+							&types.PathElementMemberKey{Value: "Statement"},
+							&types.PathElementMemberIndex{Value: 0},
+							&types.PathElementMemberKey{Value: "Condition"},
+							&types.PathElementMemberKey{Value: "StringEquals"},
+							&types.PathElementMemberValue{Value: "iam:PassedToService"},
+						},
+					},
+					{
+						Path: []types.PathElement{
+							&types.PathElementMemberKey{Value: "Statement"},
+							&types.PathElementMemberIndex{Value: 0},
+							&types.PathElementMemberKey{Value: "Resource"},
+							&types.PathElementMemberSubstring{Value: types.Substring{Start: aws.Int32(4), Length: aws.Int32(1)}},
+						},
+					},
+				},
+			},
+			expected: `policy: Statement[0].Condition.StringEquals["iam:PassedToService"], Statement[0].Resource[4:5]: SECURITY_WARNING PASS_ROLE_WITH_STAR_IN_RESOURCE: Using PassRole with a wildcard in the resource can be overly permissive.`,
+		},
+		"span": {
+			finding: types.ValidatePolicyFinding{
+				FindingDetails: aws.String("The policy is not valid JSON."),
+				FindingType:    types.ValidatePolicyFindingTypeError,
+				IssueCode:      aws.String("JSON_SYNTAX_ERROR"),
+				Locations: []types.Location{
+					{
+						Span: &types.Span{
+							Start: &types.Position{Column: aws.Int32(5), Line: aws.Int32(3), Offset: aws.Int32(21)},
+						},
+					},
+				},
+			},
+			expected: "policy: line 3, column 5: ERROR JSON_SYNTAX_ERROR: The policy is not valid JSON.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfaccessanalyzer.PolicyValidationFindingError(names.AttrPolicy, testCase.finding)
+
+			if got, want := err.Error(), testCase.expected; got != want {
+				t.Errorf("PolicyValidationFindingError() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -598,17 +598,28 @@ func waitAgentUpdated(ctx context.Context, conn *bedrockagent.Client, id string,
 }
 
 func waitAgentPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	pending := enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPreparing)
+	target := enum.Slice(awstypes.AgentStatusPrepared)
+	failureReasons := func(v *awstypes.Agent) []string {
+		return v.FailureReasons
+	}
+
+	return waitPrepared(ctx, statusAgent(ctx, conn, id), pending, target, failureReasons, timeout)
+}
+
+// waitPrepared waits for the working draft of a preparable resource (an agent or a flow) to be prepared.
+func waitPrepared[T any](ctx context.Context, refresh retry.StateRefreshFunc, pending, target []string, failureReasons func(*T) []string, timeout time.Duration) (*T, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.AgentStatusNotPrepared, awstypes.AgentStatusPreparing),
-		Target:  enum.Slice(awstypes.AgentStatusPrepared),
-		Refresh: statusAgent(ctx, conn, id),
+		Pending: pending,
+		Target:  target,
+		Refresh: refresh,
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*awstypes.Agent); ok {
-		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+	if output, ok := outputRaw.(*T); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(failureReasons(output), errors.New)...))
 
 		return output, err
 	}
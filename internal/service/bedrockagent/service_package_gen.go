@@ -62,6 +62,27 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_bedrockagent_data_source",
 			Name:     "Data Source",
 		},
+		{
+			Factory:  newFlowResource,
+			TypeName: "aws_bedrockagent_flow",
+			Name:     "Flow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newFlowAliasResource,
+			TypeName: "aws_bedrockagent_flow_alias",
+			Name:     "Flow Alias",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newFlowVersionResource,
+			TypeName: "aws_bedrockagent_flow_version",
+			Name:     "Flow Version",
+		},
 		{
 			Factory:  newKnowledgeBaseResource,
 			TypeName: "aws_bedrockagent_knowledge_base",
@@ -70,6 +91,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newPromptResource,
+			TypeName: "aws_bedrockagent_prompt",
+			Name:     "Prompt",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newPromptVersionResource,
+			TypeName: "aws_bedrockagent_prompt_version",
+			Name:     "Prompt Version",
+		},
 	}
 }
 
@@ -9,4 +9,14 @@ import (
 
 const (
 	propagationTimeout = 2 * time.Minute
+
+	stackDriftDetectionTimeout    = 10 * time.Minute
+	stackSetDriftDetectionTimeout = 30 * time.Minute
+)
+
+const (
+	stackResourceType = "AWS::CloudFormation::Stack"
+
+	// stackFailureMaxNestingDepth limits how deep into nested stacks failure diagnostics recurse.
+	stackFailureMaxNestingDepth = 10
 )
@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func expandParameters(params map[string]interface{}) []awstypes.Parameter {
@@ -50,3 +51,44 @@ func flattenParameters(cfParams []awstypes.Parameter,
 	}
 	return params
 }
+
+func flattenPropertyDifferences(apiObjects []awstypes.PropertyDifference) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"actual_value":    aws.ToString(apiObject.ActualValue),
+			"difference_type": string(apiObject.DifferenceType),
+			"expected_value":  aws.ToString(apiObject.ExpectedValue),
+			"property_path":   aws.ToString(apiObject.PropertyPath),
+		})
+	}
+	return tfList
+}
+
+func flattenStackResourceDrifts(apiObjects []awstypes.StackResourceDrift) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"logical_resource_id":         aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id":        aws.ToString(apiObject.PhysicalResourceId),
+			"property_differences":        flattenPropertyDifferences(apiObject.PropertyDifferences),
+			names.AttrResourceType:        aws.ToString(apiObject.ResourceType),
+			"stack_resource_drift_status": string(apiObject.StackResourceDriftStatus),
+		})
+	}
+	return tfList
+}
+
+func flattenStackInstanceResourceDriftsSummaries(apiObjects []awstypes.StackInstanceResourceDriftsSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"logical_resource_id":         aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id":        aws.ToString(apiObject.PhysicalResourceId),
+			"property_differences":        flattenPropertyDifferences(apiObject.PropertyDifferences),
+			names.AttrResourceType:        aws.ToString(apiObject.ResourceType),
+			"stack_resource_drift_status": string(apiObject.StackResourceDriftStatus),
+		})
+	}
+	return tfList
+}
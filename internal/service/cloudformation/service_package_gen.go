@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Stack",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceStackEvents,
+			TypeName: "aws_cloudformation_stack_events",
+			Name:     "Stack Events",
+		},
 		{
 			Factory:  dataSourceType,
 			TypeName: "aws_cloudformation_type",
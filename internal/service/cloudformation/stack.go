@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -43,7 +44,11 @@ func resourceStack() *schema.Resource {
 		DeleteWithoutTimeout: resourceStackDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("detect_drift", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -61,11 +66,21 @@ func resourceStack() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Capability](),
 				},
 			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_resources": driftedResourcesSchema(),
 			names.AttrIAMRoleARN: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -137,10 +152,64 @@ func resourceStack() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			customdiff.ComputedIf("drift_status", hasDrifted),
+			customdiff.ComputedIf("drifted_resources", hasDrifted),
 		),
 	}
 }
 
+// driftedResourcesSchema returns the schema of the resources found to have drifted during drift detection.
+func driftedResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"logical_resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"physical_resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"property_differences": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"actual_value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"difference_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"expected_value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"property_path": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				names.AttrResourceType: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"stack_resource_drift_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
@@ -209,10 +278,16 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation Stack (%s) create: %s", d.Id(), err)
 	}
 
-	return append(diags, resourceStackRead(ctx, d, meta)...)
+	return append(diags, stackRead(ctx, d, meta, false)...)
 }
 
 func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return stackRead(ctx, d, meta, d.Get("detect_drift").(bool))
+}
+
+// stackRead reads the stack, running drift detection if detectDrift is set.
+// Drift detection is skipped when reading the stack after it's created.
+func stackRead(ctx context.Context, d *schema.ResourceData, meta interface{}, detectDrift bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
@@ -270,6 +345,37 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
 
+	switch {
+	case detectDrift:
+		output, err := detectStackDrift(ctx, conn, d.Id(), stackDriftDetectionTimeout)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "detecting CloudFormation Stack (%s) drift: %s", d.Id(), err)
+		}
+
+		if output.DetectionStatus == awstypes.StackDriftDetectionStatusDetectionFailed {
+			diags = sdkdiag.AppendWarningf(diags, "CloudFormation Stack (%s) drift detection failed for some resources: %s", d.Id(), aws.ToString(output.DetectionStatusReason))
+		}
+
+		drifts, err := findStackResourceDriftsByName(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFormation Stack (%s) resource drifts: %s", d.Id(), err)
+		}
+
+		if output.StackDriftStatus == awstypes.StackDriftStatusDrifted {
+			diags = sdkdiag.AppendWarningf(diags, "CloudFormation Stack (%s) has drifted: %s", d.Id(), stackResourceDriftsString(drifts))
+		}
+
+		d.Set("drift_status", output.StackDriftStatus)
+		if err := d.Set("drifted_resources", flattenStackResourceDrifts(drifts)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting drifted_resources: %s", err)
+		}
+	case !d.Get("detect_drift").(bool):
+		d.Set("drift_status", nil)
+		d.Set("drifted_resources", nil)
+	}
+
 	setTagsOut(ctx, stack.Tags)
 
 	return diags
@@ -473,27 +579,15 @@ func waitStackCreated(ctx context.Context, conn *cloudformation.Client, name, re
 	switch output.StackStatus {
 	// This will be the case if either disable_rollback is false or on_failure is ROLLBACK
 	case awstypes.StackStatusRollbackComplete, awstypes.StackStatusRollbackFailed:
-		if events := getStackRollbackEvents(ctx, conn, name, requestToken); len(events) > 0 {
-			reasonErr = stackEventsError(events)
-		} else {
-			reasonErr = errors.New(aws.ToString(output.StackStatusReason))
-		}
+		reasonErr = stackFailureError(ctx, conn, output, requestToken, getStackRollbackEvents(ctx, conn, name, requestToken))
 
 	// This will be the case if on_failure is DELETE
 	case awstypes.StackStatusDeleteComplete, awstypes.StackStatusDeleteFailed:
-		if events := getStackDeletionEvents(ctx, conn, name, requestToken); len(events) > 0 {
-			reasonErr = stackEventsError(events)
-		} else {
-			reasonErr = errors.New(aws.ToString(output.StackStatusReason))
-		}
+		reasonErr = stackFailureError(ctx, conn, output, requestToken, getStackDeletionEvents(ctx, conn, name, requestToken))
 
 	// This will be the case if either disable_rollback is true or on_failure is DO_NOTHING
 	case awstypes.StackStatusCreateFailed:
-		if events := getStackFailureEvents(ctx, conn, name, requestToken); len(events) > 0 {
-			reasonErr = stackEventsError(events)
-		} else {
-			reasonErr = errors.New(aws.ToString(output.StackStatusReason))
-		}
+		reasonErr = stackFailureError(ctx, conn, output, requestToken, getStackFailureEvents(ctx, conn, name, requestToken))
 	}
 
 	if reasonErr != nil {
@@ -530,11 +624,7 @@ func waitStackUpdated(ctx context.Context, conn *cloudformation.Client, name, re
 
 	switch output.StackStatus {
 	case awstypes.StackStatusUpdateRollbackComplete, awstypes.StackStatusUpdateRollbackFailed:
-		if events := getStackRollbackEvents(ctx, conn, name, requestToken); len(events) > 0 {
-			reasonErr = stackEventsError(events)
-		} else {
-			reasonErr = errors.New(aws.ToString(output.StackStatusReason))
-		}
+		reasonErr = stackFailureError(ctx, conn, output, requestToken, getStackRollbackEvents(ctx, conn, name, requestToken))
 	}
 
 	if reasonErr != nil {
@@ -575,11 +665,7 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 
 	switch output.StackStatus {
 	case awstypes.StackStatusDeleteFailed:
-		if events := getStackFailureEvents(ctx, conn, name, requestToken); len(events) > 0 {
-			reasonErr = stackEventsError(events)
-		} else {
-			reasonErr = errors.New(aws.ToString(output.StackStatusReason))
-		}
+		reasonErr = stackFailureError(ctx, conn, output, requestToken, getStackFailureEvents(ctx, conn, name, requestToken))
 	}
 
 	if reasonErr != nil {
@@ -589,7 +675,103 @@ func waitStackDeleted(ctx context.Context, conn *cloudformation.Client, name, re
 	return output, err
 }
 
+// findStackResourceDriftsByName returns the stack's resources that were modified or deleted as of the last drift detection.
+func findStackResourceDriftsByName(ctx context.Context, conn *cloudformation.Client, name string) ([]awstypes.StackResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName:                       aws.String(name),
+		StackResourceDriftStatusFilters: []awstypes.StackResourceDriftStatus{awstypes.StackResourceDriftStatusModified, awstypes.StackResourceDriftStatusDeleted},
+	}
+	var output []awstypes.StackResourceDrift
+
+	pages := cloudformation.NewDescribeStackResourceDriftsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.StackResourceDrifts...)
+	}
+
+	return output, nil
+}
+
+func findStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.Client, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStackDriftDetection(ctx context.Context, conn *cloudformation.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findStackDriftDetectionStatusByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DetectionStatus), nil
+	}
+}
+
+func waitStackDriftDetectionCompleted(ctx context.Context, conn *cloudformation.Client, id string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StackDriftDetectionStatusDetectionInProgress),
+		Target:     enum.Slice(awstypes.StackDriftDetectionStatusDetectionComplete, awstypes.StackDriftDetectionStatusDetectionFailed),
+		Refresh:    statusStackDriftDetection(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// detectStackDrift starts drift detection on the stack and waits for it to finish.
+// Drift detection that fails for some of the stack's resources is not treated as an error.
+func detectStackDrift(ctx context.Context, conn *cloudformation.Client, name string, timeout time.Duration) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(name),
+	}
+
+	output, err := conn.DetectStackDrift(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitStackDriftDetectionCompleted(ctx, conn, aws.ToString(output.StackDriftDetectionId), timeout)
+}
+
 func findStackEventsForOperation(ctx context.Context, conn *cloudformation.Client, name, requestToken string, filter tfslices.Predicate[*awstypes.StackEvent]) ([]awstypes.StackEvent, error) {
+	return findStackEvents(ctx, conn, name, tfslices.PredicateAnd(isOperationEvent(requestToken), filter))
+}
+
+// findStackEvents returns the stack's events matching the filter, most recent first.
+func findStackEvents(ctx context.Context, conn *cloudformation.Client, name string, filter tfslices.Predicate[*awstypes.StackEvent]) ([]awstypes.StackEvent, error) {
 	input := &cloudformation.DescribeStackEventsInput{
 		StackName: aws.String(name),
 	}
@@ -606,10 +788,6 @@ func findStackEventsForOperation(ctx context.Context, conn *cloudformation.Clien
 		}
 
 		for _, v := range page.StackEvents {
-			if aws.ToString(v.ClientRequestToken) != requestToken {
-				continue
-			}
-
 			if filter(&v) {
 				output = append(output, v)
 			}
@@ -649,6 +827,19 @@ func getStackFailureEvents(ctx context.Context, conn *cloudformation.Client, nam
 	return events
 }
 
+func isOperationEvent(requestToken string) tfslices.Predicate[*awstypes.StackEvent] {
+	return func(event *awstypes.StackEvent) bool {
+		return aws.ToString(event.ClientRequestToken) == requestToken
+	}
+}
+
+// isEventSince returns a predicate matching events at or after the specified time.
+func isEventSince(t time.Time) tfslices.Predicate[*awstypes.StackEvent] {
+	return func(event *awstypes.StackEvent) bool {
+		return !aws.ToTime(event.Timestamp).Before(t)
+	}
+}
+
 func isFailedEvent(event *awstypes.StackEvent) bool {
 	return strings.HasSuffix(string(event.ResourceStatus), "_FAILED") && event.ResourceStatusReason != nil
 }
@@ -659,7 +850,7 @@ func isRollbackEvent(event *awstypes.StackEvent) bool {
 
 func isStackDeletionEvent(event *awstypes.StackEvent) bool {
 	return event.ResourceStatus == awstypes.ResourceStatusDeleteInProgress &&
-		aws.ToString(event.ResourceType) == "AWS::CloudFormation::Stack" &&
+		aws.ToString(event.ResourceType) == stackResourceType &&
 		event.ResourceStatusReason != nil
 }
 
@@ -667,6 +858,142 @@ func stackEventsError(events []awstypes.StackEvent) error {
 	return errors.Join(tfslices.ApplyToAll(events, func(event awstypes.StackEvent) error { return errors.New(aws.ToString(event.ResourceStatusReason)) })...)
 }
 
+// isCancelledEvent returns whether the event records a resource operation cancelled because another resource failed.
+func isCancelledEvent(event *awstypes.StackEvent) bool {
+	return strings.Contains(aws.ToString(event.ResourceStatusReason), "cancelled")
+}
+
+func isNestedStackEvent(event *awstypes.StackEvent) bool {
+	return aws.ToString(event.ResourceType) == stackResourceType &&
+		aws.ToString(event.PhysicalResourceId) != "" &&
+		aws.ToString(event.PhysicalResourceId) != aws.ToString(event.StackId)
+}
+
+// stackResourceFailure describes the first resource to fail in a stack operation.
+type stackResourceFailure struct {
+	event awstypes.StackEvent
+	// path holds the logical IDs of the nested stacks, outermost first, containing the failed resource.
+	path []string
+}
+
+func (f *stackResourceFailure) Error() string {
+	logicalID := strings.Join(append(slices.Clone(f.path), aws.ToString(f.event.LogicalResourceId)), "/")
+
+	return fmt.Sprintf("%s (%s) %s: %s", logicalID, aws.ToString(f.event.ResourceType), f.event.ResourceStatus, aws.ToString(f.event.ResourceStatusReason))
+}
+
+// findStackOperationFailure returns the first resource to fail in the stack operation identified by the client request token.
+// If the failed resource is a nested stack, the nested stack's events are searched for the resource that caused it to fail.
+func findStackOperationFailure(ctx context.Context, conn *cloudformation.Client, name, requestToken string) (*stackResourceFailure, error) {
+	events, err := findStackEventsForOperation(ctx, conn, name, requestToken, tfslices.PredicateTrue[*awstypes.StackEvent]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findStackEventsFailure(ctx, conn, events, nil, 0)
+}
+
+func findStackEventsFailure(ctx context.Context, conn *cloudformation.Client, events []awstypes.StackEvent, path []string, depth int) (*stackResourceFailure, error) {
+	// Events are returned most recent first.
+	events = slices.Clone(events)
+	slices.Reverse(events)
+
+	var failed *awstypes.StackEvent
+	for _, v := range events {
+		if !isFailedEvent(&v) || aws.ToString(v.PhysicalResourceId) == aws.ToString(v.StackId) {
+			continue
+		}
+
+		if failed == nil || (isCancelledEvent(failed) && !isCancelledEvent(&v)) {
+			failed = &v
+		}
+
+		if !isCancelledEvent(failed) {
+			break
+		}
+	}
+
+	if failed == nil {
+		return nil, nil
+	}
+
+	failure := &stackResourceFailure{
+		event: *failed,
+		path:  path,
+	}
+
+	if !isNestedStackEvent(failed) || depth >= stackFailureMaxNestingDepth {
+		return failure, nil
+	}
+
+	// The nested stack's operation started no earlier than the first event for the nested stack resource in this operation.
+	logicalID := aws.ToString(failed.LogicalResourceId)
+	since := aws.ToTime(failed.Timestamp)
+	for _, v := range events {
+		if aws.ToString(v.LogicalResourceId) == logicalID {
+			since = aws.ToTime(v.Timestamp)
+			break
+		}
+	}
+
+	nestedEvents, err := findStackEvents(ctx, conn, aws.ToString(failed.PhysicalResourceId), isEventSince(since))
+
+	if err != nil {
+		return nil, err
+	}
+
+	nestedFailure, err := findStackEventsFailure(ctx, conn, nestedEvents, append(slices.Clone(path), logicalID), depth+1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if nestedFailure != nil {
+		return nestedFailure, nil
+	}
+
+	return failure, nil
+}
+
+// stackFailureError returns an error describing the first resource to fail in the stack operation, if it can be found,
+// followed by the reasons of the specified events, or the stack's status reason if there are none.
+func stackFailureError(ctx context.Context, conn *cloudformation.Client, stack *awstypes.Stack, requestToken string, events []awstypes.StackEvent) error {
+	var errs []error
+	name := aws.ToString(stack.StackId)
+
+	if failure, err := findStackOperationFailure(ctx, conn, name, requestToken); err != nil {
+		log.Printf("[WARN] finding CloudFormation Stack (%s) operation failure: %s", name, err)
+	} else if failure != nil {
+		errs = append(errs, failure)
+	}
+
+	if len(events) > 0 {
+		errs = append(errs, stackEventsError(events))
+	} else {
+		errs = append(errs, errors.New(aws.ToString(stack.StackStatusReason)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasDrifted returns whether drift detection is enabled and the last drift detection found drift.
+// Drift is then shown as a planned update of the drift detection results.
+func hasDrifted(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
+	if d.Id() == "" || !d.Get("detect_drift").(bool) {
+		return false
+	}
+
+	return d.Get("drift_status").(string) == string(awstypes.StackDriftStatusDrifted)
+}
+
+// stackResourceDriftsString returns a description of the drifted resources, e.g. "MyVPC (AWS::EC2::VPC) MODIFIED".
+func stackResourceDriftsString(apiObjects []awstypes.StackResourceDrift) string {
+	return strings.Join(tfslices.ApplyToAll(apiObjects, func(v awstypes.StackResourceDrift) string {
+		return fmt.Sprintf("%s (%s) %s", aws.ToString(v.LogicalResourceId), aws.ToString(v.ResourceType), v.StackResourceDriftStatus)
+	}), ", ")
+}
+
 func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
 	if d.Id() == "" {
 		return false
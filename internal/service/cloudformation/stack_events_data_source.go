@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudformation_stack_events", name="Stack Events")
+func dataSourceStackEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStackEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_request_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_request_token": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"include_nested_stacks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	name := d.Get("stack_name").(string)
+	filter := tfslices.PredicateTrue[*awstypes.StackEvent]()
+	if v, ok := d.GetOk("client_request_token"); ok {
+		filter = isOperationEvent(v.(string))
+	}
+
+	events, err := findStackEvents(ctx, conn, name, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation Stack (%s) events: %s", name, err)
+	}
+
+	if d.Get("include_nested_stacks").(bool) {
+		nestedEvents, err := findNestedStackEvents(ctx, conn, events, 0)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFormation Stack (%s) nested stack events: %s", name, err)
+		}
+
+		events = append(events, nestedEvents...)
+	}
+
+	// Events are returned most recent first.
+	slices.Reverse(events)
+	slices.SortStableFunc(events, func(a, b awstypes.StackEvent) int {
+		return aws.ToTime(a.Timestamp).Compare(aws.ToTime(b.Timestamp))
+	})
+
+	d.SetId(name)
+	if err := d.Set("events", flattenStackEvents(events)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting events: %s", err)
+	}
+
+	return diags
+}
+
+// findNestedStackEvents returns the events of the nested stacks referenced by the specified events.
+// Only nested stack events at or after the first reference to the nested stack are returned.
+func findNestedStackEvents(ctx context.Context, conn *cloudformation.Client, events []awstypes.StackEvent, depth int) ([]awstypes.StackEvent, error) {
+	if depth >= stackFailureMaxNestingDepth {
+		return nil, nil
+	}
+
+	var stackIDs []string
+	since := make(map[string]time.Time)
+	for _, v := range events {
+		if !isNestedStackEvent(&v) {
+			continue
+		}
+
+		stackID, timestamp := aws.ToString(v.PhysicalResourceId), aws.ToTime(v.Timestamp)
+		if t, ok := since[stackID]; !ok {
+			stackIDs = append(stackIDs, stackID)
+			since[stackID] = timestamp
+		} else if timestamp.Before(t) {
+			since[stackID] = timestamp
+		}
+	}
+
+	var output []awstypes.StackEvent
+	for _, stackID := range stackIDs {
+		nestedEvents, err := findStackEvents(ctx, conn, stackID, isEventSince(since[stackID]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, nestedEvents...)
+
+		nestedEvents, err = findNestedStackEvents(ctx, conn, nestedEvents, depth+1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, nestedEvents...)
+	}
+
+	return output, nil
+}
+
+func flattenStackEvents(apiObjects []awstypes.StackEvent) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"client_request_token":   aws.ToString(apiObject.ClientRequestToken),
+			"event_id":               aws.ToString(apiObject.EventId),
+			"logical_resource_id":    aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id":   aws.ToString(apiObject.PhysicalResourceId),
+			"resource_status":        string(apiObject.ResourceStatus),
+			"resource_status_reason": aws.ToString(apiObject.ResourceStatusReason),
+			names.AttrResourceType:   aws.ToString(apiObject.ResourceType),
+			"stack_id":               aws.ToString(apiObject.StackId),
+			"stack_name":             aws.ToString(apiObject.StackName),
+		}
+
+		if v := apiObject.Timestamp; v != nil {
+			tfMap["timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationStackEventsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_events.test"
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackEventsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.logical_resource_id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.resource_status", "CREATE_IN_PROGRESS"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.resource_type", "AWS::CloudFormation::Stack"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "events.0.stack_id", resourceName, names.AttrID),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "events.*", map[string]string{
+						"logical_resource_id": "MyVPC",
+						"resource_status":     "CREATE_COMPLETE",
+						"resource_type":       "AWS::EC2::VPC",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackEventsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackConfig_basic(rName), `
+data "aws_cloudformation_stack_events" "test" {
+  stack_name            = aws_cloudformation_stack.test.name
+  include_nested_stacks = true
+}
+`)
+}
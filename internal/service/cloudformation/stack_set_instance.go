@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -118,6 +120,16 @@ func resourceStackSetInstance() *schema.Resource {
 				},
 				ConflictsWith: []string{names.AttrAccountID},
 			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_resources": driftedResourcesSchema(),
 			"operation_preferences": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -205,6 +217,10 @@ func resourceStackSetInstance() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"organizational_unit_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -223,6 +239,11 @@ func resourceStackSetInstance() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("drift_status", hasDrifted),
+			customdiff.ComputedIf("drifted_resources", hasDrifted),
+		),
 	}
 }
 
@@ -342,10 +363,16 @@ func resourceStackSetInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "creating CloudFormation StackSet (%s) Instance: %s", stackSetName, err)
 	}
 
-	return append(diags, resourceStackSetInstanceRead(ctx, d, meta)...)
+	return append(diags, stackSetInstanceRead(ctx, d, meta, false)...)
 }
 
 func resourceStackSetInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return stackSetInstanceRead(ctx, d, meta, d.Get("detect_drift").(bool))
+}
+
+// stackSetInstanceRead reads the stack set instance, running drift detection on the stack set if detectDrift is set.
+// Drift detection is skipped when reading the stack set instance after it's created.
+func stackSetInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}, detectDrift bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
@@ -374,6 +401,38 @@ func resourceStackSetInstanceRead(ctx context.Context, d *schema.ResourceData, m
 			return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Instance (%s): %s", d.Id(), err)
 		}
 
+		if detectDrift {
+			operationID, err := detectStackSetDrift(ctx, conn, stackSetName, callAs, expandStackSetInstanceOperationPreferences(d), stackSetDriftDetectionTimeout)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet Instance (%s) drift: %s", d.Id(), err)
+			}
+
+			stackInstance, err = findStackInstanceByFourPartKey(ctx, conn, stackSetName, accountOrOrgID, region, callAs)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+			}
+
+			drifts, err := findStackInstanceResourceDriftsByFivePartKey(ctx, conn, stackSetName, accountOrOrgID, region, callAs, operationID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet Instance (%s) resource drifts: %s", d.Id(), err)
+			}
+
+			if stackInstance.DriftStatus == awstypes.StackDriftStatusDrifted {
+				diags = sdkdiag.AppendWarningf(diags, "CloudFormation StackSet Instance (%s) has drifted: %s", d.Id(), stackInstanceResourceDriftsSummariesString(drifts))
+			}
+
+			d.Set("drift_status", stackInstance.DriftStatus)
+			if err := d.Set("drifted_resources", flattenStackInstanceResourceDriftsSummaries(drifts)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting drifted_resources: %s", err)
+			}
+		} else if !d.Get("detect_drift").(bool) {
+			d.Set("drift_status", nil)
+			d.Set("drifted_resources", nil)
+		}
+
 		d.Set(names.AttrAccountID, stackInstance.Account)
 		d.Set("organizational_unit_id", stackInstance.OrganizationalUnitId)
 		if err := d.Set("parameter_overrides", flattenAllParameters(stackInstance.ParameterOverrides)); err != nil {
@@ -398,6 +457,29 @@ func resourceStackSetInstanceRead(ctx context.Context, d *schema.ResourceData, m
 			return sdkdiag.AppendErrorf(diags, "finding CloudFormation StackSet Instance (%s): %s", d.Id(), err)
 		}
 
+		if detectDrift {
+			if _, err := detectStackSetDrift(ctx, conn, stackSetName, callAs, expandStackSetInstanceOperationPreferences(d), stackSetDriftDetectionTimeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "detecting CloudFormation StackSet Instance (%s) drift: %s", d.Id(), err)
+			}
+
+			summaries, err = findStackInstanceSummariesByFourPartKey(ctx, conn, stackSetName, region, callAs, orgIDs)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "finding CloudFormation StackSet Instance (%s): %s", d.Id(), err)
+			}
+
+			driftStatus := stackInstanceSummariesDriftStatus(summaries)
+			if driftStatus == awstypes.StackDriftStatusDrifted {
+				diags = sdkdiag.AppendWarningf(diags, "CloudFormation StackSet Instance (%s) has drifted in accounts: %s", d.Id(), strings.Join(stackInstanceSummariesDriftedAccounts(summaries), ", "))
+			}
+
+			d.Set("drift_status", driftStatus)
+		} else if !d.Get("detect_drift").(bool) {
+			d.Set("drift_status", nil)
+		}
+
+		// Resource drift details are only available for stack instances deployed by account ID.
+		d.Set("drifted_resources", nil)
 		d.Set("stack_instance_summaries", flattenStackInstanceSummaries(summaries))
 	}
 
@@ -515,6 +597,8 @@ func resourceStackSetInstanceImport(ctx context.Context, d *schema.ResourceData,
 		return []*schema.ResourceData{}, fmt.Errorf("unexpected format for import ID (%[1]s), use: STACKSETNAME%[2]sACCOUNTID%[2]sREGION or STACKSETNAME%[2]sACCOUNTID%[2]sREGION%[2]sCALLAS", d.Id(), flex.ResourceIdSeparator)
 	}
 
+	d.Set("detect_drift", false)
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -583,6 +667,167 @@ func findStackInstanceByFourPartKey(ctx context.Context, conn *cloudformation.Cl
 	return output.StackInstance, nil
 }
 
+// findStackInstanceResourceDriftsByFivePartKey returns the stack instance's resources found to be modified or deleted by the specified drift detection operation.
+func findStackInstanceResourceDriftsByFivePartKey(ctx context.Context, conn *cloudformation.Client, stackSetName, accountID, region, callAs, operationID string) ([]awstypes.StackInstanceResourceDriftsSummary, error) {
+	input := &cloudformation.ListStackInstanceResourceDriftsInput{
+		OperationId:                        aws.String(operationID),
+		StackInstanceAccount:               aws.String(accountID),
+		StackInstanceRegion:                aws.String(region),
+		StackInstanceResourceDriftStatuses: []awstypes.StackResourceDriftStatus{awstypes.StackResourceDriftStatusModified, awstypes.StackResourceDriftStatusDeleted},
+		StackSetName:                       aws.String(stackSetName),
+	}
+	if callAs != "" {
+		input.CallAs = awstypes.CallAs(callAs)
+	}
+	var output []awstypes.StackInstanceResourceDriftsSummary
+
+	for {
+		page, err := conn.ListStackInstanceResourceDrifts(ctx, input)
+
+		if errs.IsA[*awstypes.StackInstanceNotFoundException](err) || errs.IsA[*awstypes.StackSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Summaries...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// detectStackSetDrift runs drift detection on all of the stack set's instances and returns the drift detection operation's ID.
+// Drift detection can't be limited to the instances in an account and Region, so the stack set's instances share drift detection operations:
+// drift detection isn't run again if an operation completed while waiting for another instance's operation to complete.
+func detectStackSetDrift(ctx context.Context, conn *cloudformation.Client, stackSetName, callAs string, operationPreferences *awstypes.StackSetOperationPreferences, timeout time.Duration) (string, error) {
+	start := time.Now()
+
+	mutexKey := "cloudformation-stack-set-drift-" + stackSetName
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	operation, err := findStackSetDriftDetectionOperationCompletedSince(ctx, conn, stackSetName, callAs, start)
+
+	switch {
+	case err == nil:
+		return aws.ToString(operation.OperationId), nil
+	case !tfresource.NotFound(err):
+		return "", fmt.Errorf("reading operations: %w", err)
+	}
+
+	input := &cloudformation.DetectStackSetDriftInput{
+		OperationId:          aws.String(sdkid.UniqueId()),
+		OperationPreferences: operationPreferences,
+		StackSetName:         aws.String(stackSetName),
+	}
+	if callAs != "" {
+		input.CallAs = awstypes.CallAs(callAs)
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.OperationInProgressException](ctx, timeout, func() (interface{}, error) {
+		return conn.DetectStackSetDrift(ctx, input)
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	operationID := aws.ToString(outputRaw.(*cloudformation.DetectStackSetDriftOutput).OperationId)
+
+	if _, err := waitStackSetOperationSucceeded(ctx, conn, stackSetName, operationID, callAs, timeout); err != nil {
+		return "", fmt.Errorf("waiting for completion (%s): %w", operationID, err)
+	}
+
+	return operationID, nil
+}
+
+// findStackSetDriftDetectionOperationCompletedSince returns the stack set's drift detection operation that succeeded after the specified time.
+func findStackSetDriftDetectionOperationCompletedSince(ctx context.Context, conn *cloudformation.Client, stackSetName, callAs string, since time.Time) (*awstypes.StackSetOperationSummary, error) {
+	input := &cloudformation.ListStackSetOperationsInput{
+		StackSetName: aws.String(stackSetName),
+	}
+	if callAs != "" {
+		input.CallAs = awstypes.CallAs(callAs)
+	}
+
+	pages := cloudformation.NewListStackSetOperationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.StackSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Summaries {
+			if v.Action == awstypes.StackSetOperationActionDetectDrift && v.Status == awstypes.StackSetOperationStatusSucceeded && aws.ToTime(v.EndTimestamp).After(since) {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+// stackInstanceSummariesDriftStatus returns DRIFTED if any of the stack instances has drifted,
+// IN_SYNC if all of them are in sync and UNKNOWN otherwise.
+func stackInstanceSummariesDriftStatus(apiObjects []awstypes.StackInstanceSummary) awstypes.StackDriftStatus {
+	if slices.ContainsFunc(apiObjects, func(v awstypes.StackInstanceSummary) bool { return v.DriftStatus == awstypes.StackDriftStatusDrifted }) {
+		return awstypes.StackDriftStatusDrifted
+	}
+
+	if len(apiObjects) > 0 && !slices.ContainsFunc(apiObjects, func(v awstypes.StackInstanceSummary) bool { return v.DriftStatus != awstypes.StackDriftStatusInSync }) {
+		return awstypes.StackDriftStatusInSync
+	}
+
+	return awstypes.StackDriftStatusUnknown
+}
+
+// stackInstanceSummariesDriftedAccounts returns the IDs of the accounts whose stack instances have drifted.
+func stackInstanceSummariesDriftedAccounts(apiObjects []awstypes.StackInstanceSummary) []string {
+	var accountIDs []string
+
+	for _, v := range apiObjects {
+		if v.DriftStatus == awstypes.StackDriftStatusDrifted {
+			accountIDs = append(accountIDs, aws.ToString(v.Account))
+		}
+	}
+
+	return accountIDs
+}
+
+// stackInstanceResourceDriftsSummariesString returns a description of the drifted resources, e.g. "MyVPC (AWS::EC2::VPC) MODIFIED".
+func stackInstanceResourceDriftsSummariesString(apiObjects []awstypes.StackInstanceResourceDriftsSummary) string {
+	return strings.Join(tfslices.ApplyToAll(apiObjects, func(v awstypes.StackInstanceResourceDriftsSummary) string {
+		return fmt.Sprintf("%s (%s) %s", aws.ToString(v.LogicalResourceId), aws.ToString(v.ResourceType), v.StackResourceDriftStatus)
+	}), ", ")
+}
+
+func expandStackSetInstanceOperationPreferences(d *schema.ResourceData) *awstypes.StackSetOperationPreferences {
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return nil
+}
+
 func expandDeploymentTargets(tfList []interface{}) *awstypes.DeploymentTargets {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -619,6 +864,7 @@ func flattenStackInstanceSummaries(apiObject []awstypes.StackInstanceSummary) []
 	for _, obj := range apiObject {
 		m := map[string]interface{}{
 			names.AttrAccountID:      obj.Account,
+			"drift_status":           string(obj.DriftStatus),
 			"organizational_unit_id": obj.OrganizationalUnitId,
 			"stack_id":               obj.StackId,
 		}
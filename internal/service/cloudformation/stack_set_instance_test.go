@@ -135,6 +135,40 @@ func TestAccCloudFormationStackSetInstance_Disappears_stackSet(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSetInstance_detectDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackInstance1 awstypes.StackInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetInstanceConfig_detectDrift(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackSetInstanceExists(ctx, resourceName, &stackInstance1),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", acctest.CtTrue),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusInSync)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "0"),
+				),
+			},
+			{
+				Config:   testAccStackSetInstanceConfig_detectDrift(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSetInstance_parameterOverrides(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackInstance1, stackInstance2, stackInstance3, stackInstance4 awstypes.StackInstance
@@ -701,6 +735,17 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 `)
 }
 
+func testAccStackSetInstanceConfig_detectDrift(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig(rName), `
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  detect_drift   = true
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`)
+}
+
 func testAccStackSetInstanceConfig_parameterOverrides1(rName, value1 string) string {
 	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig(rName), fmt.Sprintf(`
 resource "aws_cloudformation_stack_set_instance" "test" {
@@ -9,7 +9,10 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -72,7 +75,7 @@ func TestAccCloudFormationStack_CreationFailure_doNothing(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccStackConfig_creationFailure(rName, string(awstypes.OnFailureDoNothing)),
-				ExpectError: regexache.MustCompile(`(?s)stack status \(CREATE_FAILED\).*The following resource\(s\) failed to create.*This is not a valid CIDR block`),
+				ExpectError: regexache.MustCompile(`(?s)stack status \(CREATE_FAILED\): MyVPC \(AWS::EC2::VPC\) CREATE_FAILED: .*This is not a valid CIDR block.*The following resource\(s\) failed to create`),
 			},
 		},
 	})
@@ -167,6 +170,69 @@ func TestAccCloudFormationStack_disappears(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_detectDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_detectDrift(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", acctest.CtTrue),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusInSync)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "0"),
+					testAccCheckStackVPCModified(ctx, resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusDrifted)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.stack_resource_drift_status", string(awstypes.StackResourceDriftStatusModified)),
+					resource.TestCheckResourceAttrSet(resourceName, "drifted_resources.0.property_differences.0.property_path"),
+				),
+			},
+			{
+				// Drift shows up as a planned update of the stack.
+				Config: testAccStackConfig_detectDrift(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("drift_status")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusDrifted)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_drift", "drift_status", "drifted_resources"},
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStack_yaml(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stack awstypes.Stack
@@ -572,6 +638,28 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+// testAccCheckStackVPCModified changes the tags of the stack's VPC outside of CloudFormation.
+func testAccCheckStackVPCModified(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{rs.Primary.Attributes["outputs.VpcID"]},
+			Tags: []ec2types.Tag{{
+				Key:   aws.String("Name"),
+				Value: aws.String("Drifted_CF_VPC"),
+			}},
+		})
+
+		return err
+	}
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -688,6 +776,37 @@ STACK
 `, rName, onFailure)
 }
 
+func testAccStackConfig_detectDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name         = %[1]q
+  detect_drift = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  },
+  "Outputs" : {
+    "VpcID" : {
+      "Description": "The VPC ID",
+      "Value" : { "Ref" : "MyVPC" }
+    }
+  }
+}
+STACK
+}
+`, rName)
+}
+
 func testAccStackConfig_yaml(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "test" {
@@ -0,0 +1,680 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	dashboardGridWidth = 24
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	yAxisSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"label": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrMax: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidTypeStringNullableFloat,
+					},
+					"min": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidTypeStringNullableFloat,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarms": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "table",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"period": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ValidateFunc: validation.Any(
+											validation.IntInSlice([]int{1, 5, 10, 30}),
+											validation.IntDivisibleBy(60),
+										),
+									},
+									"query": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 500,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAccountID: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"color": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^#[0-9A-Fa-f]{6}$`), "must be a hex color code, for example #1f77b4"),
+												},
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrExpression: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 2048),
+												},
+												names.AttrID: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_]{0,254}$`), "must start with a lowercase letter and contain only alphanumeric characters and underscores"),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrMetricName: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												names.AttrNamespace: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"period": {
+													Type:     schema.TypeInt,
+													Optional: true,
+													ValidateFunc: validation.Any(
+														validation.IntInSlice([]int{1, 5, 10, 30}),
+														validation.IntDivisibleBy(60),
+													),
+												},
+												names.AttrRegion: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+												},
+											},
+										},
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "timeSeries",
+										ValidateFunc: validation.StringInSlice([]string{"bar", "gauge", "pie", "singleValue", "table", "timeSeries"}, false),
+									},
+									"y_axis": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left":  yAxisSchema(),
+												"right": yAxisSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"position": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, dashboardGridWidth-1),
+									},
+									"y": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+									},
+									"markdown": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	region := meta.(*conns.AWSClient).Region(ctx)
+
+	document := &dashboardDocument{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	for i, tfMapRaw := range d.Get("widget").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "widget (%d): %s", i, err)
+		}
+
+		document.Widgets = append(document.Widgets, widget)
+	}
+
+	jsonBytes, err := json.MarshalIndent(document, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	jsonString := string(jsonBytes)
+
+	d.Set(names.AttrJSON, jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	apiObject := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	if v, ok := tfMap["position"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		x, y := tfMap["x"].(int), tfMap["y"].(int)
+
+		if x+apiObject.Width > dashboardGridWidth {
+			return nil, fmt.Errorf("widget extends past the %d-column dashboard grid (x = %d, width = %d)", dashboardGridWidth, x, apiObject.Width)
+		}
+
+		apiObject.X, apiObject.Y = &x, &y
+	}
+
+	var n int
+
+	if v, ok := tfMap["alarm"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		apiObject.Type = "alarm"
+		apiObject.Properties = expandDashboardAlarmWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		apiObject.Type = "log"
+		apiObject.Properties = expandDashboardLogWidgetProperties(v[0].(map[string]interface{}), region)
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		apiObject.Type = "metric"
+		properties, err := expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), region)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Properties = properties
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		apiObject.Type = "text"
+		apiObject.Properties = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of alarm, log, metric or text must be configured")
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) *dashboardAlarmWidgetProperties {
+	apiObject := &dashboardAlarmWidgetProperties{
+		Alarms: flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+		SortBy: tfMap["sort_by"].(string),
+		Title:  tfMap["title"].(string),
+	}
+
+	if v, ok := tfMap["states"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) *dashboardLogWidgetProperties {
+	apiObject := &dashboardLogWidgetProperties{
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = v
+	}
+
+	// Logs Insights queries in dashboards select their log groups with SOURCE commands.
+	var sb strings.Builder
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		fmt.Fprintf(&sb, "SOURCE '%s' | ", v)
+	}
+	sb.WriteString(tfMap["query"].(string))
+	apiObject.Query = sb.String()
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (*dashboardMetricWidgetProperties, error) {
+	apiObject := &dashboardMetricWidgetProperties{
+		Period:  tfMap["period"].(int),
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Stat:    tfMap["stat"].(string),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = v
+	}
+
+	ids := make(map[string]struct{})
+	for i, tfMapRaw := range tfMap["query"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id := tfMap[names.AttrID].(string); id != "" {
+			if _, ok := ids[id]; ok {
+				return nil, fmt.Errorf("metric query (%d): duplicate id %q", i, id)
+			}
+			ids[id] = struct{}{}
+		}
+
+		metric, err := expandDashboardMetric(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("metric query (%d): %w", i, err)
+		}
+
+		apiObject.Metrics = append(apiObject.Metrics, metric)
+	}
+
+	if v, ok := tfMap["y_axis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.YAxis = &dashboardYAxes{
+			Left:  expandDashboardYAxis(tfMap["left"].([]interface{})),
+			Right: expandDashboardYAxis(tfMap["right"].([]interface{})),
+		}
+	}
+
+	if apiObject.View == "gauge" && (apiObject.YAxis == nil || apiObject.YAxis.Left == nil || apiObject.YAxis.Left.Min == nil || apiObject.YAxis.Left.Max == nil) {
+		return nil, fmt.Errorf("gauge view requires y_axis.left min and max")
+	}
+
+	return apiObject, nil
+}
+
+// expandDashboardMetric returns a single entry of a metric widget's "metrics" array.
+// Expressions are a single options object; metrics are the namespace, metric name and
+// dimension name/value pairs, optionally followed by an options object.
+func expandDashboardMetric(tfMap map[string]interface{}) ([]interface{}, error) {
+	options := dashboardMetricOptions{
+		AccountID: tfMap[names.AttrAccountID].(string),
+		Color:     tfMap["color"].(string),
+		ID:        tfMap[names.AttrID].(string),
+		Label:     tfMap["label"].(string),
+		Period:    tfMap["period"].(int),
+		Region:    tfMap[names.AttrRegion].(string),
+		Stat:      tfMap["stat"].(string),
+		YAxis:     tfMap["y_axis"].(string),
+	}
+
+	if !tfMap["visible"].(bool) {
+		options.Visible = new(bool)
+	}
+
+	expression := tfMap[names.AttrExpression].(string)
+	metricName := tfMap[names.AttrMetricName].(string)
+	namespace := tfMap[names.AttrNamespace].(string)
+	dimensions, _ := tfMap["dimensions"].(map[string]interface{})
+
+	if expression != "" {
+		if metricName != "" || namespace != "" || len(dimensions) > 0 {
+			return nil, fmt.Errorf("expression cannot be combined with namespace, metric_name or dimensions")
+		}
+
+		options.Expression = expression
+
+		return []interface{}{options}, nil
+	}
+
+	if metricName == "" || namespace == "" {
+		return nil, fmt.Errorf("either expression or both namespace and metric_name must be configured")
+	}
+
+	metric := []interface{}{namespace, metricName}
+
+	keys := make([]string, 0, len(dimensions))
+	for k := range dimensions {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		metric = append(metric, k, dimensions[k].(string))
+	}
+
+	if options != (dashboardMetricOptions{}) {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+func expandDashboardYAxis(tfList []interface{}) *dashboardYAxis {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &dashboardYAxis{
+		Label: tfMap["label"].(string),
+	}
+
+	if v, err := strconv.ParseFloat(tfMap[names.AttrMax].(string), 64); err == nil {
+		apiObject.Max = &v
+	}
+
+	if v, err := strconv.ParseFloat(tfMap["min"].(string), 64); err == nil {
+		apiObject.Min = &v
+	}
+
+	return apiObject
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) *dashboardTextWidgetProperties {
+	return &dashboardTextWidgetProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
+
+type dashboardDocument struct {
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Start          string             `json:"start,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string `json:"type"`
+	X          *int   `json:"x,omitempty"`
+	Y          *int   `json:"y,omitempty"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Properties any    `json:"properties"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	Metrics [][]interface{} `json:"metrics"`
+	Period  int             `json:"period,omitempty"`
+	Region  string          `json:"region"`
+	Stacked bool            `json:"stacked,omitempty"`
+	Stat    string          `json:"stat,omitempty"`
+	Title   string          `json:"title,omitempty"`
+	View    string          `json:"view,omitempty"`
+	YAxis   *dashboardYAxes `json:"yAxis,omitempty"`
+}
+
+type dashboardMetricOptions struct {
+	AccountID  string `json:"accountId,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+type dashboardYAxes struct {
+	Left  *dashboardYAxis `json:"left,omitempty"`
+	Right *dashboardYAxis `json:"right,omitempty"`
+}
+
+type dashboardYAxis struct {
+	Label string   `json:"label,omitempty"`
+	Max   *float64 `json:"max,omitempty"`
+	Min   *float64 `json:"min,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON("data.aws_cloudwatch_dashboard_document.test", names.AttrJSON, testAccDashboardDocumentDataSourceConfig_basic_expectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "dashboard_body", testAccDashboardDocumentDataSourceConfig_basic_expectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_noContent,
+				ExpectError: regexache.MustCompile(`exactly one of alarm, log, metric or text must be configured`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_outsideGrid,
+				ExpectError: regexache.MustCompile(`widget extends past the 24-column dashboard grid`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_expressionWithMetric,
+				ExpectError: regexache.MustCompile(`expression cannot be combined with namespace, metric_name or dimensions`),
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic_widgets = `
+  widget {
+    width  = 24
+    height = 2
+
+    position {
+      x = 0
+      y = 0
+    }
+
+    text {
+      markdown = "# Capacity"
+    }
+  }
+
+  widget {
+    width  = 12
+    height = 6
+
+    metric {
+      title  = "CPU"
+      region = "us-west-2"
+      period = 300
+      stat   = "Average"
+
+      query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        visible     = false
+
+        dimensions = {
+          InstanceId = "i-1234567890abcdef0"
+        }
+      }
+
+      query {
+        id         = "e1"
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+`
+
+var testAccDashboardDocumentDataSourceConfig_basic = fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  start = "-PT6H"
+%[1]s
+}
+`, testAccDashboardDocumentDataSourceConfig_basic_widgets)
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  start = "-PT6H"
+%[2]s
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName, testAccDashboardDocumentDataSourceConfig_basic_widgets)
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic_expectedJSON = `{
+  "start": "-PT6H",
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 2,
+      "properties": {
+        "markdown": "# Capacity"
+      }
+    },
+    {
+      "type": "metric",
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "InstanceId", "i-1234567890abcdef0", {"id": "m1", "visible": false}],
+          [{"expression": "m1 * 2", "id": "e1", "label": "Doubled"}]
+        ],
+        "period": 300,
+        "region": "us-west-2",
+        "stat": "Average",
+        "title": "CPU",
+        "view": "timeSeries"
+      }
+    }
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_noContent = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 6
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_outsideGrid = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    position {
+      x = 18
+      y = 0
+    }
+
+    text {
+      markdown = "too wide"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_expressionWithMetric = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      query {
+        id          = "e1"
+        expression  = "SUM(METRICS())"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+    }
+  }
+}
+`
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchMetricDataDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.id", "m1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.0.status_code", "Complete"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.1.id", "e1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.1.label", "Doubled"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStartTime),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricDataDataSource_startTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_metric_data.test"
+	startTime := time.Now().UTC().Add(-6 * time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(-1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricDataDataSourceConfig_startTime(startTime, endTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "end_time", endTime),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStartTime, startTime),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_data_results.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMetricDataDataSourceConfig_basic = `
+data "aws_cloudwatch_metric_data" "test" {
+  lookback_period = "3h"
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      namespace   = "AWS/Usage"
+      metric_name = "CallCount"
+      period      = 300
+      stat        = "Sum"
+
+      dimensions = {
+        Type     = "API"
+        Resource = "GetMetricData"
+        Service  = "CloudWatch"
+        Class    = "None"
+      }
+    }
+  }
+
+  metric_query {
+    id         = "e1"
+    expression = "m1 * 2"
+    label      = "Doubled"
+  }
+}
+`
+
+func testAccMetricDataDataSourceConfig_startTime(startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_metric_data" "test" {
+  start_time = %[1]q
+  end_time   = %[2]q
+  scan_by    = "TimestampDescending"
+
+  metric_query {
+    id = "m1"
+
+    metric {
+      namespace   = "AWS/Usage"
+      metric_name = "CallCount"
+      period      = 3600
+      stat        = "Sum"
+
+      dimensions = {
+        Type     = "API"
+        Resource = "GetMetricData"
+        Service  = "CloudWatch"
+        Class    = "None"
+      }
+    }
+  }
+}
+`, startTime, endTime)
+}
@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of items in a BatchGetItem request.
+	batchGetItemMaxItems = 100
+	// Maximum number of requests in a BatchWriteItem request.
+	batchWriteItemMaxItems = 25
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var requests []awstypes.WriteRequest
+	for _, item := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keys := make([]map[string]awstypes.AttributeValue, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, expandTableItemQueryKey(item, hashKey, rangeKey))
+	}
+
+	output, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	found := make(map[string]map[string]awstypes.AttributeValue, len(output))
+	for _, item := range output {
+		found[tableItemCreateResourceID(tableName, hashKey, rangeKey, item)] = item
+	}
+
+	// Items that no longer exist are removed and items that differ are replaced, so that they show as differences.
+	tfMap := make(map[string]interface{}, len(items))
+	for k, item := range items {
+		v, ok := found[tableItemCreateResourceID(tableName, hashKey, rangeKey, item)]
+
+		if !ok {
+			continue
+		}
+
+		if tableItemsEqual(v, item) {
+			tfMap[k] = d.Get("items").(map[string]interface{})[k]
+
+			continue
+		}
+
+		itemAttrs, err := flattenTableItemAttributes(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tfMap[k] = itemAttrs
+	}
+
+	d.Set("items", tfMap)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChange("items") {
+		tableName := d.Get(names.AttrTableName).(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		o, n := d.GetChange("items")
+		oldItems, err := expandTableItems(o.(map[string]interface{}))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		newItems, err := expandTableItems(n.(map[string]interface{}))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		newKeys := make(map[string]struct{}, len(newItems))
+		for _, item := range newItems {
+			newKeys[tableItemCreateResourceID(tableName, hashKey, rangeKey, item)] = struct{}{}
+		}
+
+		var requests []awstypes.WriteRequest
+
+		// Delete items whose key is no longer present.
+		for _, item := range oldItems {
+			if _, ok := newKeys[tableItemCreateResourceID(tableName, hashKey, rangeKey, item)]; ok {
+				continue
+			}
+
+			requests = append(requests, awstypes.WriteRequest{
+				DeleteRequest: &awstypes.DeleteRequest{
+					Key: expandTableItemQueryKey(item, hashKey, rangeKey),
+				},
+			})
+		}
+
+		// Put new and changed items.
+		for k, item := range newItems {
+			if v, ok := oldItems[k]; ok && tableItemsEqual(v, item) {
+				continue
+			}
+
+			requests = append(requests, awstypes.WriteRequest{
+				PutRequest: &awstypes.PutRequest{
+					Item: item,
+				},
+			})
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var requests []awstypes.WriteRequest
+	for _, item := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: expandTableItemQueryKey(item, hashKey, rangeKey),
+			},
+		})
+	}
+
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Items may not be known until apply.
+	if !d.NewValueKnown("items") || !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return nil
+	}
+
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	keys := make(map[string]string)
+
+	for k, v := range d.Get("items").(map[string]interface{}) {
+		item, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return fmt.Errorf("invalid format of items[%q]: %w", k, err)
+		}
+
+		for _, key := range []string{hashKey, rangeKey} {
+			if key == "" {
+				continue
+			}
+
+			if _, ok := item[key]; !ok {
+				return fmt.Errorf("items[%q] is missing key attribute %q", k, key)
+			}
+		}
+
+		id := tableItemCreateResourceID("", hashKey, rangeKey, item)
+		if other, ok := keys[id]; ok {
+			return fmt.Errorf("items[%q] and items[%q] have the same key", other, k)
+		}
+		keys[id] = k
+	}
+
+	return nil
+}
+
+// batchWriteTableItems writes the specified requests in batches, retrying any unprocessed items.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxItems) {
+		r := tfretry.BeginWithOptions(tfretry.Options{
+			BackoffMinDuration: 100 * time.Millisecond,
+			BackoffMultiplier:  2,
+		})
+
+		for r.Continue(ctx) {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: chunk,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			chunk = output.UnprocessedItems[tableName]
+
+			if len(chunk) == 0 {
+				break
+			}
+		}
+
+		if len(chunk) > 0 {
+			return fmt.Errorf("%d unprocessed items: %w", len(chunk), ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified keys in batches, retrying any unprocessed keys.
+// Items that don't exist aren't returned.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue) ([]map[string]awstypes.AttributeValue, error) {
+	var output []map[string]awstypes.AttributeValue
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxItems) {
+		r := tfretry.BeginWithOptions(tfretry.Options{
+			BackoffMinDuration: 100 * time.Millisecond,
+			BackoffMultiplier:  2,
+		})
+
+		for r.Continue(ctx) {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           chunk,
+					},
+				},
+			}
+
+			page, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil, &sdkretry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				}
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, page.Responses[tableName]...)
+			chunk = page.UnprocessedKeys[tableName].Keys
+
+			if len(chunk) == 0 {
+				break
+			}
+		}
+
+		if len(chunk) > 0 {
+			return nil, fmt.Errorf("%d unprocessed keys: %w", len(chunk), ctx.Err())
+		}
+	}
+
+	return output, nil
+}
+
+// tableItemsEqual returns whether two items are equivalent.
+// DynamoDB doesn't preserve the order of set members, so items are compared as normalized JSON with sorted sets.
+func tableItemsEqual(a, b map[string]awstypes.AttributeValue) bool {
+	x, err := flattenTableItemAttributes(tfmaps.ApplyToAllValues(a, normalizeTableItemAttribute))
+	if err != nil {
+		return false
+	}
+
+	y, err := flattenTableItemAttributes(tfmaps.ApplyToAllValues(b, normalizeTableItemAttribute))
+	if err != nil {
+		return false
+	}
+
+	return x == y
+}
+
+func normalizeTableItemAttribute(a awstypes.AttributeValue) awstypes.AttributeValue {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		v := slices.Clone(a.Value)
+		slices.SortFunc(v, bytes.Compare)
+		return &awstypes.AttributeValueMemberBS{Value: v}
+	case *awstypes.AttributeValueMemberL:
+		return &awstypes.AttributeValueMemberL{Value: tfslices.ApplyToAll(a.Value, normalizeTableItemAttribute)}
+	case *awstypes.AttributeValueMemberM:
+		return &awstypes.AttributeValueMemberM{Value: tfmaps.ApplyToAllValues(a.Value, normalizeTableItemAttribute)}
+	case *awstypes.AttributeValueMemberNS:
+		return &awstypes.AttributeValueMemberNS{Value: slices.Sorted(slices.Values(a.Value))}
+	case *awstypes.AttributeValueMemberSS:
+		return &awstypes.AttributeValueMemberSS{Value: slices.Sorted(slices.Values(a.Value))}
+	}
+
+	return a
+}
+
+func expandTableItems(tfMap map[string]interface{}) (map[string]map[string]awstypes.AttributeValue, error) {
+	apiObjects := make(map[string]map[string]awstypes.AttributeValue, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObject, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("items[%q]: %w", k, err)
+		}
+
+		apiObjects[k] = apiObject
+	}
+
+	return apiObjects, nil
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTableItemsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b     map[string]awstypes.AttributeValue
+		expected bool
+	}{
+		"equal": {
+			a:        map[string]awstypes.AttributeValue{"attr": &awstypes.AttributeValueMemberS{Value: "a"}},
+			b:        map[string]awstypes.AttributeValue{"attr": &awstypes.AttributeValueMemberS{Value: "a"}},
+			expected: true,
+		},
+		"different": {
+			a:        map[string]awstypes.AttributeValue{"attr": &awstypes.AttributeValueMemberS{Value: "a"}},
+			b:        map[string]awstypes.AttributeValue{"attr": &awstypes.AttributeValueMemberS{Value: "b"}},
+			expected: false,
+		},
+		"reordered sets": {
+			a: map[string]awstypes.AttributeValue{
+				"bs": &awstypes.AttributeValueMemberBS{Value: [][]byte{[]byte("blob1"), []byte("blob2")}},
+				"ns": &awstypes.AttributeValueMemberNS{Value: []string{"1", "2"}},
+				"ss": &awstypes.AttributeValueMemberSS{Value: []string{"a", "b"}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"bs": &awstypes.AttributeValueMemberBS{Value: [][]byte{[]byte("blob2"), []byte("blob1")}},
+				"ns": &awstypes.AttributeValueMemberNS{Value: []string{"2", "1"}},
+				"ss": &awstypes.AttributeValueMemberSS{Value: []string{"b", "a"}},
+			},
+			expected: true,
+		},
+		"reordered nested set": {
+			a: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+					"ss": &awstypes.AttributeValueMemberSS{Value: []string{"a", "b"}},
+				}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+					"ss": &awstypes.AttributeValueMemberSS{Value: []string{"b", "a"}},
+				}},
+			},
+			expected: true,
+		},
+		"reordered list": {
+			a: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+					&awstypes.AttributeValueMemberS{Value: "a"},
+					&awstypes.AttributeValueMemberS{Value: "b"},
+				}},
+			},
+			b: map[string]awstypes.AttributeValue{
+				"attr": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+					&awstypes.AttributeValueMemberS{Value: "b"},
+					&awstypes.AttributeValueMemberS{Value: "a"},
+				}},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdynamodb.TableItemsEqual(testCase.a, testCase.b), testCase.expected; got != want {
+				t.Errorf("TableItemsEqual() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// More items than fit in a single BatchWriteItem or BatchGetItem request.
+				Config: testAccTableItemsConfig_basic(rName, 120, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 120),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "120"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.item-007", `{"pk": {"S": "item-007"}, "value": {"N": "7"}}`),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 110, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 110),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "110"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items.item-007", `{"pk": {"S": "item-007"}, "value": {"N": "14"}}`),
+					resource.TestCheckNoResourceAttr(resourceName, "items.item-115"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			keys, err := testAccTableItemsKeys(rs)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("DynamoDB Table Items %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		keys, err := testAccTableItemsKeys(rs)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(keys); got != want {
+			return fmt.Errorf("DynamoDB Table Items %s: found %d items, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsKeys(rs *terraform.ResourceState) ([]map[string]awstypes.AttributeValue, error) {
+	var keys []map[string]awstypes.AttributeValue
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "items.") || k == "items.%" {
+			continue
+		}
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(v)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, tfdynamodb.ExpandTableItemQueryKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"]))
+	}
+
+	return keys, nil
+}
+
+func testAccTableItemsConfig_basic(rName string, count, multiplier int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = {
+    for i in range(%[2]d) : format("item-%%03d", i) => jsonencode({
+      pk    = { S = format("item-%%03d", i) }
+      value = { N = tostring(i * %[3]d) }
+    })
+  }
+}
+`, rName, count, multiplier)
+}
+
+func testAccTableItemsConfig_rangeKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = {
+    first = <<ITEM
+{
+  "pk": {"S": "config"},
+  "sk": {"N": "1"},
+  "enabled": {"BOOL": true}
+}
+ITEM
+    second = <<ITEM
+{
+  "pk": {"S": "config"},
+  "sk": {"N": "2"},
+  "tags": {"SS": ["a", "b"]}
+}
+ITEM
+    third = <<ITEM
+{
+  "pk": {"S": "other"},
+  "sk": {"N": "1"},
+  "data": {"M": {"nested": {"S": "value"}}}
+}
+ITEM
+  }
+}
+`, rName)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dynamodb_table_query", name="Table Query")
+func dataSourceTableQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"consistent_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"expression_attribute_names": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expression_attribute_values": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateTableItem,
+			},
+			"filter_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_condition_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"projection_expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scan_index_forward": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"scanned_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTableQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	keyConditionExpression := d.Get("key_condition_expression").(string)
+	input := &dynamodb.QueryInput{
+		ConsistentRead:         aws.Bool(d.Get("consistent_read").(bool)),
+		KeyConditionExpression: aws.String(keyConditionExpression),
+		ScanIndexForward:       aws.Bool(d.Get("scan_index_forward").(bool)),
+		TableName:              aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("expression_attribute_names"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ExpressionAttributeNames = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("expression_attribute_values"); ok {
+		values, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.ExpressionAttributeValues = values
+	}
+
+	if v, ok := d.GetOk("filter_expression"); ok {
+		input.FilterExpression = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("index_name"); ok {
+		input.IndexName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("projection_expression"); ok {
+		input.ProjectionExpression = aws.String(v.(string))
+	}
+
+	items, scannedCount, err := findTableQueryItems(ctx, conn, input, d.Get("limit").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "querying DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	tfList := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		itemAttrs, err := flattenTableItemAttributes(item)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tfList = append(tfList, itemAttrs)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%d", tableName, create.StringHashcode(keyConditionExpression)))
+	d.Set("items", tfList)
+	d.Set("scanned_count", scannedCount)
+
+	return diags
+}
+
+// findTableQueryItems returns the items matching the specified query.
+// If limit is greater than zero, at most that many items are returned.
+func findTableQueryItems(ctx context.Context, conn *dynamodb.Client, input *dynamodb.QueryInput, limit int) ([]map[string]awstypes.AttributeValue, int32, error) {
+	var output []map[string]awstypes.AttributeValue
+	var scannedCount int32
+
+	pages := dynamodb.NewQueryPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		output = append(output, page.Items...)
+		scannedCount += page.ScannedCount
+
+		if limit > 0 && len(output) >= limit {
+			return output[:limit], scannedCount, nil
+		}
+	}
+
+	return output, scannedCount, nil
+}
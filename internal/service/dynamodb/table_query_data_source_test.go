@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DynamoDB)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", "3"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.0", `{"pk": {"S": "config"}, "sk": {"N": "1"}, "enabled": {"BOOL": true}}`),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.2", `{"pk": {"S": "config"}, "sk": {"N": "3"}, "enabled": {"BOOL": true}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "scanned_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableQueryDataSource_filterExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DynamoDB)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableQueryDataSourceConfig_filterExpression(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "items.#", "1"),
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "items.0", `{"sk": {"N": "3"}}`),
+					resource.TestCheckResourceAttr(dataSourceName, "scanned_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableQueryDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = {
+    for i, enabled in [true, false, true] : tostring(i) => jsonencode({
+      pk      = { S = "config" }
+      sk      = { N = tostring(i + 1) }
+      enabled = { BOOL = enabled }
+    })
+  }
+}
+
+resource "aws_dynamodb_table_item" "other" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  item = jsonencode({
+    pk = { S = "other" }
+    sk = { N = "1" }
+  })
+}
+`, rName)
+}
+
+func testAccTableQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableQueryDataSourceConfig_base(rName), `
+data "aws_dynamodb_table_query" "test" {
+  table_name               = aws_dynamodb_table.test.name
+  key_condition_expression = "pk = :pk"
+  consistent_read          = true
+  expression_attribute_values = jsonencode({
+    ":pk" = { S = "config" }
+  })
+
+  depends_on = [aws_dynamodb_table_items.test, aws_dynamodb_table_item.other]
+}
+`)
+}
+
+func testAccTableQueryDataSourceConfig_filterExpression(rName string) string {
+	return acctest.ConfigCompose(testAccTableQueryDataSourceConfig_base(rName), `
+data "aws_dynamodb_table_query" "test" {
+  table_name               = aws_dynamodb_table.test.name
+  key_condition_expression = "pk = :pk AND sk > :sk"
+  filter_expression        = "#enabled = :enabled"
+  projection_expression    = "sk"
+  consistent_read          = true
+  scan_index_forward       = false
+
+  expression_attribute_names = {
+    "#enabled" = "enabled"
+  }
+  expression_attribute_values = jsonencode({
+    ":pk"      = { S = "config" }
+    ":sk"      = { N = "1" }
+    ":enabled" = { BOOL = true }
+  })
+
+  depends_on = [aws_dynamodb_table_items.test, aws_dynamodb_table_item.other]
+}
+`)
+}
@@ -93,6 +93,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_eip_domain_name",
 			Name:     "EIP Domain Name",
 		},
+		{
+			Factory:  newNetworkACLRulesExclusiveResource,
+			TypeName: "aws_network_acl_rules_exclusive",
+			Name:     "Network ACL Rules Exclusive",
+		},
+		{
+			Factory:  newRouteTableRoutesExclusiveResource,
+			TypeName: "aws_route_table_routes_exclusive",
+			Name:     "Route Table Routes Exclusive",
+		},
 		{
 			Factory:  newVPCBlockPublicAccessExclusionResource,
 			TypeName: "aws_vpc_block_public_access_exclusion",
@@ -124,6 +134,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupEgressRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_egress_rules_exclusive",
+			Name:     "Security Group Egress Rules Exclusive",
+		},
 		{
 			Factory:  newSecurityGroupIngressRuleResource,
 			TypeName: "aws_vpc_security_group_ingress_rule",
@@ -132,6 +147,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupIngressRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_ingress_rules_exclusive",
+			Name:     "Security Group Ingress Rules Exclusive",
+		},
 		{
 			Factory:  newResourceSecurityGroupVPCAssociation,
 			TypeName: "aws_vpc_security_group_vpc_association",
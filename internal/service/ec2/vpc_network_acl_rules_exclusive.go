@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameNetworkACLRulesExclusive = "Network ACL Rules Exclusive"
+)
+
+// @FrameworkResource("aws_network_acl_rules_exclusive", name="Network ACL Rules Exclusive")
+func newNetworkACLRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &networkACLRulesExclusiveResource{}, nil
+}
+
+type networkACLRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*networkACLRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_network_acl_rules_exclusive"
+}
+
+func (r *networkACLRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"network_acl_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *networkACLRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkACLRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	naclID := data.NetworkACLID.ValueString()
+	if err := r.syncRules(ctx, &data); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameNetworkACLRulesExclusive, naclID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkACLRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkACLRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	naclID := data.NetworkACLID.ValueString()
+	entries, err := findNetworkACLRulesExclusiveEntries(ctx, conn, naclID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameNetworkACLRulesExclusive, naclID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.EgressRuleNumbers, diags = flattenNetworkACLRuleNumbers(ctx, entries, true)
+	response.Diagnostics.Append(diags...)
+	data.IngressRuleNumbers, diags = flattenNetworkACLRuleNumbers(ctx, entries, false)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkACLRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new networkACLRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.EgressRuleNumbers.Equal(old.EgressRuleNumbers) || !new.IngressRuleNumbers.Equal(old.IngressRuleNumbers) {
+		naclID := new.NetworkACLID.ValueString()
+		if err := r.syncRules(ctx, &new); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameNetworkACLRulesExclusive, naclID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *networkACLRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("network_acl_id"), request, response)
+}
+
+// syncRules handles keeping the configured network ACL rules in sync with the
+// remote resource.
+//
+// Rules in the network ACL but not configured on this resource will be
+// deleted. Rules configured on this resource must already exist in the
+// network ACL, as this resource cannot create them.
+func (r *networkACLRulesExclusiveResource) syncRules(ctx context.Context, data *networkACLRulesExclusiveResourceModel) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	naclID := data.NetworkACLID.ValueString()
+	entries, err := findNetworkACLRulesExclusiveEntries(ctx, conn, naclID)
+	if err != nil {
+		return err
+	}
+
+	for _, egress := range []bool{false, true} {
+		want := fwflex.ExpandFrameworkInt32ValueSet(ctx, data.IngressRuleNumbers)
+		if egress {
+			want = fwflex.ExpandFrameworkInt32ValueSet(ctx, data.EgressRuleNumbers)
+		}
+
+		have := tfslices.ApplyToAll(tfslices.Filter(entries, func(v awstypes.NetworkAclEntry) bool {
+			return aws.ToBool(v.Egress) == egress
+		}), func(v awstypes.NetworkAclEntry) int32 {
+			return aws.ToInt32(v.RuleNumber)
+		})
+
+		missing, remove, _ := intflex.DiffSlices(have, want, func(n1, n2 int32) bool { return n1 == n2 })
+
+		if len(missing) > 0 {
+			return fmt.Errorf("rule(s) (%s) not found in network ACL (%s) (egress: %t)", joinRuleNumbers(missing), naclID, egress)
+		}
+
+		for _, ruleNumber := range remove {
+			input := &ec2.DeleteNetworkAclEntryInput{
+				Egress:       aws.Bool(egress),
+				NetworkAclId: aws.String(naclID),
+				RuleNumber:   aws.Int32(ruleNumber),
+			}
+
+			_, err := conn.DeleteNetworkAclEntry(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkACLEntryNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting EC2 Network ACL (%s) Rule (egress: %t)(%d): %w", naclID, egress, ruleNumber, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findNetworkACLRulesExclusiveEntries returns the entries in the specified
+// network ACL, excluding the default rules that can be neither modified nor
+// destroyed.
+func findNetworkACLRulesExclusiveEntries(ctx context.Context, conn *ec2.Client, naclID string) ([]awstypes.NetworkAclEntry, error) {
+	nacl, err := findNetworkACLByID(ctx, conn, naclID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(nacl.Entries, func(v awstypes.NetworkAclEntry) bool {
+		v1 := aws.ToInt32(v.RuleNumber)
+		return v1 != defaultACLRuleNumberIPv4 && v1 != defaultACLRuleNumberIPv6
+	}), nil
+}
+
+func flattenNetworkACLRuleNumbers(ctx context.Context, apiObjects []awstypes.NetworkAclEntry, egress bool) (types.Set, diag.Diagnostics) {
+	ruleNumbers := make([]int64, 0)
+
+	for _, v := range apiObjects {
+		if aws.ToBool(v.Egress) == egress {
+			ruleNumbers = append(ruleNumbers, int64(aws.ToInt32(v.RuleNumber)))
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.Int64Type, ruleNumbers)
+}
+
+func joinRuleNumbers(ruleNumbers []int32) string {
+	return strings.Join(tfslices.ApplyToAll(ruleNumbers, func(v int32) string {
+		return strconv.Itoa(int(v))
+	}), ", ")
+}
+
+type networkACLRulesExclusiveResourceModel struct {
+	EgressRuleNumbers  types.Set    `tfsdk:"egress_rule_numbers"`
+	IngressRuleNumbers types.Set    `tfsdk:"ingress_rule_numbers"`
+	NetworkACLID       types.String `tfsdk:"network_acl_id"`
+}
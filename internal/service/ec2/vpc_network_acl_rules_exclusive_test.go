@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkACLRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nacl awstypes.NetworkAcl
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_acl_rules_exclusive.test"
+	naclResourceName := "aws_network_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists(ctx, naclResourceName, &nacl),
+					resource.TestCheckResourceAttrPair(resourceName, "network_acl_id", naclResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_numbers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_numbers.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ingress_rule_numbers.*", "200"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ingress_rule_numbers.*", "300"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ingress_rule_numbers.*", "400"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "network_acl_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "network_acl_id",
+			},
+		},
+	})
+}
+
+// A rule added out of band should be deleted.
+func TestAccVPCNetworkACLRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nacl awstypes.NetworkAcl
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_acl_rules_exclusive.test"
+	naclResourceName := "aws_network_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkACLExists(ctx, naclResourceName, &nacl),
+					testAccCheckNetworkACLCreateIngressEntry(ctx, &nacl, 500),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_numbers.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkACLRulesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_network_acl_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkACLRulesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_numbers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_numbers.#", "0"),
+				),
+				// The empty rule number arguments will delete the rules
+				// defined in this configuration, so a diff is expected.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkACLCreateIngressEntry(ctx context.Context, v *awstypes.NetworkAcl, ruleNumber int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.CreateNetworkAclEntry(ctx, &ec2.CreateNetworkAclEntryInput{
+			CidrBlock:    aws.String("0.0.0.0/0"),
+			Egress:       aws.Bool(false),
+			NetworkAclId: v.NetworkAclId,
+			PortRange:    &awstypes.PortRange{From: aws.Int32(443), To: aws.Int32(443)},
+			Protocol:     aws.String("6"),
+			RuleAction:   awstypes.RuleActionAllow,
+			RuleNumber:   aws.Int32(ruleNumber),
+		})
+
+		return err
+	}
+}
+
+func testAccVPCNetworkACLRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRuleConfig_basic(rName), `
+resource "aws_network_acl_rules_exclusive" "test" {
+  network_acl_id       = aws_network_acl.test.id
+  egress_rule_numbers  = []
+  ingress_rule_numbers = [
+    aws_network_acl_rule.test1.rule_number,
+    aws_network_acl_rule.test2.rule_number,
+    aws_network_acl_rule.test3.rule_number,
+  ]
+}
+`)
+}
+
+func testAccVPCNetworkACLRulesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkACLRuleConfig_basic(rName), `
+resource "aws_network_acl_rules_exclusive" "test" {
+  # Wait until the rules are created, then provision the exclusive lock
+  # which will delete them. This creates a diff on the next plan
+  # (to re-create the aws_network_acl_rule resources) which the test can
+  # check for.
+  depends_on = [
+    aws_network_acl_rule.test1,
+    aws_network_acl_rule.test2,
+    aws_network_acl_rule.test3,
+  ]
+
+  network_acl_id       = aws_network_acl.test.id
+  egress_rule_numbers  = []
+  ingress_rule_numbers = []
+}
+`)
+}
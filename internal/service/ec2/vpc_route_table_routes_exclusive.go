@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameRouteTableRoutesExclusive = "Route Table Routes Exclusive"
+)
+
+// @FrameworkResource("aws_route_table_routes_exclusive", name="Route Table Routes Exclusive")
+func newRouteTableRoutesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &routeTableRoutesExclusiveResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type routeTableRoutesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*routeTableRoutesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route_table_routes_exclusive"
+}
+
+func (r *routeTableRoutesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"destinations": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ignore_local_routes": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"ignore_propagated_routes": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *routeTableRoutesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routeTableID := data.RouteTableID.ValueString()
+	if err := r.syncRoutes(ctx, &data, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameRouteTableRoutesExclusive, routeTableID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources have no values for the optional arguments.
+	if data.IgnoreLocalRoutes.IsNull() {
+		data.IgnoreLocalRoutes = types.BoolValue(true)
+	}
+	if data.IgnorePropagatedRoutes.IsNull() {
+		data.IgnorePropagatedRoutes = types.BoolValue(true)
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := data.RouteTableID.ValueString()
+	routes, err := findRouteTableRoutesExclusiveRoutes(ctx, conn, routeTableID, data.IgnoreLocalRoutes.ValueBool(), data.IgnorePropagatedRoutes.ValueBool())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameRouteTableRoutesExclusive, routeTableID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Retain the configured representation of equivalent CIDR blocks.
+	configured := fwflex.ExpandFrameworkStringValueSet(ctx, data.Destinations)
+	destinations := tfslices.ApplyToAll(routes, func(v awstypes.Route) string {
+		destination := routeDestination(v)
+		if i := slices.IndexFunc(configured, func(s string) bool { return routeDestinationsEqual(s, destination) }); i != -1 {
+			return configured[i]
+		}
+		return destination
+	})
+	data.Destinations = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, destinations)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new routeTableRoutesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Destinations.Equal(old.Destinations) ||
+		!new.IgnoreLocalRoutes.Equal(old.IgnoreLocalRoutes) ||
+		!new.IgnorePropagatedRoutes.Equal(old.IgnorePropagatedRoutes) {
+		routeTableID := new.RouteTableID.ValueString()
+		if err := r.syncRoutes(ctx, &new, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameRouteTableRoutesExclusive, routeTableID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *routeTableRoutesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("route_table_id"), request, response)
+}
+
+// syncRoutes handles keeping the configured routes in sync with the remote
+// resource.
+//
+// Routes in the route table but not configured on this resource will be
+// deleted. Routes configured on this resource must already exist in the
+// route table, as this resource cannot create them.
+func (r *routeTableRoutesExclusiveResource) syncRoutes(ctx context.Context, data *routeTableRoutesExclusiveResourceModel, timeout time.Duration) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	routeTableID := data.RouteTableID.ValueString()
+	routes, err := findRouteTableRoutesExclusiveRoutes(ctx, conn, routeTableID, data.IgnoreLocalRoutes.ValueBool(), data.IgnorePropagatedRoutes.ValueBool())
+	if err != nil {
+		return err
+	}
+
+	have := tfslices.ApplyToAll(routes, routeDestination)
+	want := fwflex.ExpandFrameworkStringValueSet(ctx, data.Destinations)
+
+	missing, remove, _ := intflex.DiffSlices(have, want, routeDestinationsEqual)
+
+	if len(missing) > 0 {
+		return fmt.Errorf("route(s) with destination (%s) not found in route table (%s)", strings.Join(missing, ", "), routeTableID)
+	}
+
+	for _, destination := range remove {
+		i := slices.Index(have, destination)
+		route := routes[i]
+
+		switch {
+		case aws.ToString(route.GatewayId) == gatewayIDLocal:
+			return fmt.Errorf("local route with destination (%s) cannot be deleted from route table (%s), add it to destinations or set ignore_local_routes", destination, routeTableID)
+		case route.Origin == awstypes.RouteOriginEnableVgwRoutePropagation:
+			return fmt.Errorf("propagated route with destination (%s) cannot be deleted from route table (%s), add it to destinations or set ignore_propagated_routes", destination, routeTableID)
+		}
+
+		if err := deleteRouteByDestination(ctx, conn, routeTableID, route, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findRouteTableRoutesExclusiveRoutes returns the routes in the specified
+// route table that are subject to exclusive management.
+//
+// Routes for VPC Lattice and gateway VPC endpoints are managed by their owning
+// resources and are never returned.
+func findRouteTableRoutesExclusiveRoutes(ctx context.Context, conn *ec2.Client, routeTableID string, ignoreLocal, ignorePropagated bool) ([]awstypes.Route, error) {
+	routeTable, err := findRouteTableByID(ctx, conn, routeTableID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(routeTable.Routes, func(v awstypes.Route) bool {
+		gatewayID := aws.ToString(v.GatewayId)
+
+		if gatewayID == gatewayIDVPCLattice {
+			return false
+		}
+
+		if v.DestinationPrefixListId != nil && strings.HasPrefix(gatewayID, "vpce-") {
+			return false
+		}
+
+		if ignoreLocal && gatewayID == gatewayIDLocal {
+			return false
+		}
+
+		if ignorePropagated && v.Origin == awstypes.RouteOriginEnableVgwRoutePropagation {
+			return false
+		}
+
+		return true
+	}), nil
+}
+
+// routeDestination returns the destination of the specified route.
+func routeDestination(apiObject awstypes.Route) string {
+	switch {
+	case apiObject.DestinationCidrBlock != nil:
+		return aws.ToString(apiObject.DestinationCidrBlock)
+	case apiObject.DestinationIpv6CidrBlock != nil:
+		return aws.ToString(apiObject.DestinationIpv6CidrBlock)
+	default:
+		return aws.ToString(apiObject.DestinationPrefixListId)
+	}
+}
+
+func routeDestinationsEqual(d1, d2 string) bool {
+	return d1 == d2 || itypes.CIDRBlocksEqual(d1, d2)
+}
+
+// deleteRouteByDestination deletes the specified route from a route table.
+func deleteRouteByDestination(ctx context.Context, conn *ec2.Client, routeTableID string, apiObject awstypes.Route, timeout time.Duration) error {
+	input := &ec2.DeleteRouteInput{
+		RouteTableId: aws.String(routeTableID),
+	}
+
+	var routeFinder routeFinder
+
+	switch {
+	case apiObject.DestinationCidrBlock != nil:
+		input.DestinationCidrBlock = apiObject.DestinationCidrBlock
+		routeFinder = findRouteByIPv4Destination
+	case apiObject.DestinationIpv6CidrBlock != nil:
+		input.DestinationIpv6CidrBlock = apiObject.DestinationIpv6CidrBlock
+		routeFinder = findRouteByIPv6Destination
+	default:
+		input.DestinationPrefixListId = apiObject.DestinationPrefixListId
+		routeFinder = findRouteByPrefixListIDDestination
+	}
+
+	destination := routeDestination(apiObject)
+
+	_, err := conn.DeleteRoute(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	if _, err := waitRouteDeleted(ctx, conn, routeFinder, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) delete: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+type routeTableRoutesExclusiveResourceModel struct {
+	Destinations           types.Set      `tfsdk:"destinations"`
+	IgnoreLocalRoutes      types.Bool     `tfsdk:"ignore_local_routes"`
+	IgnorePropagatedRoutes types.Bool     `tfsdk:"ignore_propagated_routes"`
+	RouteTableID           types.String   `tfsdk:"route_table_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteTableRoutesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	destinationCidr := "10.3.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName, destinationCidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", routeTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destinations.*", destinationCidr),
+					resource.TestCheckResourceAttr(resourceName, "ignore_local_routes", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ignore_propagated_routes", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "route_table_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "route_table_id",
+			},
+		},
+	})
+}
+
+// A route added out of band should be deleted.
+func TestAccVPCRouteTableRoutesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	destinationCidr := "10.3.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName, destinationCidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableCreateRoute(ctx, &routeTable, "10.4.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName, destinationCidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destinations.*", destinationCidr),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	destinationCidr := "10.3.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_empty(rName, destinationCidr),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "0"),
+				),
+				// The empty `destinations` argument will delete the route
+				// defined in this configuration, so a diff is expected.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTableCreateRoute(ctx context.Context, v *awstypes.RouteTable, destinationCidr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		var gatewayID *string
+		for _, v := range v.Routes {
+			if aws.ToString(v.GatewayId) != "local" {
+				gatewayID = v.GatewayId
+				break
+			}
+		}
+
+		if gatewayID == nil {
+			return fmt.Errorf("no non-local route found in Route Table (%s)", aws.ToString(v.RouteTableId))
+		}
+
+		_, err := conn.CreateRoute(ctx, &ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destinationCidr),
+			GatewayId:            gatewayID,
+			RouteTableId:         v.RouteTableId,
+		})
+
+		return err
+	}
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_basic(rName, destinationCidr string) string {
+	return acctest.ConfigCompose(testAccVPCRouteConfig_ipv4InternetGateway(rName, destinationCidr), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+  destinations   = [aws_route.test.destination_cidr_block]
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_empty(rName, destinationCidr string) string {
+	return acctest.ConfigCompose(testAccVPCRouteConfig_ipv4InternetGateway(rName, destinationCidr), `
+resource "aws_route_table_routes_exclusive" "test" {
+  # Wait until the route is created, then provision the exclusive lock
+  # which will delete it. This creates a diff on the next plan
+  # (to re-create aws_route.test) which the test can check for.
+  depends_on = [aws_route.test]
+
+  route_table_id = aws_route_table.test.id
+  destinations   = []
+}
+`)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_egress_rules_exclusive", name="Security Group Egress Rules Exclusive")
+func newSecurityGroupEgressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupEgressRulesExclusiveResource{}
+	r.securityGroupRulesExclusive = r
+
+	return r, nil
+}
+
+type securityGroupEgressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (*securityGroupEgressRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_egress_rules_exclusive"
+}
+
+func (*securityGroupEgressRulesExclusiveResource) isEgress() bool {
+	return true
+}
+
+func (*securityGroupEgressRulesExclusiveResource) resourceName() string {
+	return ResNameSecurityGroupEgressRulesExclusive
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) revoke(ctx context.Context, securityGroupID string, ruleIDs []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(securityGroupID),
+		SecurityGroupRuleIds: ruleIDs,
+	})
+
+	return err
+}
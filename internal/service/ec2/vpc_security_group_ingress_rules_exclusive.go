@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameSecurityGroupIngressRulesExclusive = "Security Group Ingress Rules Exclusive"
+	ResNameSecurityGroupEgressRulesExclusive  = "Security Group Egress Rules Exclusive"
+)
+
+// @FrameworkResource("aws_vpc_security_group_ingress_rules_exclusive", name="Security Group Ingress Rules Exclusive")
+func newSecurityGroupIngressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupIngressRulesExclusiveResource{}
+	r.securityGroupRulesExclusive = r
+
+	return r, nil
+}
+
+type securityGroupIngressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (*securityGroupIngressRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_ingress_rules_exclusive"
+}
+
+func (*securityGroupIngressRulesExclusiveResource) isEgress() bool {
+	return false
+}
+
+func (*securityGroupIngressRulesExclusiveResource) resourceName() string {
+	return ResNameSecurityGroupIngressRulesExclusive
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) revoke(ctx context.Context, securityGroupID string, ruleIDs []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	_, err := conn.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(securityGroupID),
+		SecurityGroupRuleIds: ruleIDs,
+	})
+
+	return err
+}
+
+// Base structure and methods for VPC security group exclusive rules.
+
+type securityGroupRulesExclusive interface {
+	isEgress() bool
+	resourceName() string
+	revoke(context.Context, string, []string) error
+}
+
+type securityGroupRulesExclusiveResource struct {
+	securityGroupRulesExclusive
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	if err := r.syncRules(ctx, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.SecurityGroupRuleIDs)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, r.resourceName(), securityGroupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	ruleIDs, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID, r.isEgress())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, r.resourceName(), securityGroupID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.SecurityGroupRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, ruleIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.SecurityGroupRuleIDs.Equal(old.SecurityGroupRuleIDs) {
+		securityGroupID := new.SecurityGroupID.ValueString()
+		if err := r.syncRules(ctx, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, new.SecurityGroupRuleIDs)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, r.resourceName(), securityGroupID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules in the security group but not configured on this resource will be
+// revoked. Rules configured on this resource must already exist in the
+// security group, as this resource cannot create them.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, securityGroupID string, want []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	have, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID, r.isEgress())
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("security group rule(s) (%s) not found in security group (%s)", strings.Join(missing, ", "), securityGroupID)
+	}
+
+	if len(remove) > 0 {
+		if err := r.revoke(ctx, securityGroupID, remove); err != nil {
+			return fmt.Errorf("revoking security group rule(s) (%s): %w", strings.Join(remove, ", "), err)
+		}
+	}
+
+	return nil
+}
+
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, securityGroupID string, isEgress bool) ([]string, error) {
+	// Ensure the security group exists so that deletion can be detected.
+	if _, err := findSecurityGroupByID(ctx, conn, securityGroupID); err != nil {
+		return nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules = tfslices.Filter(rules, func(v awstypes.SecurityGroupRule) bool {
+		return aws.ToBool(v.IsEgress) == isEgress
+	})
+
+	return tfslices.ApplyToAll(rules, func(v awstypes.SecurityGroupRule) string {
+		return aws.ToString(v.SecurityGroupRuleId)
+	}), nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	SecurityGroupID      types.String `tfsdk:"security_group_id"`
+	SecurityGroupRuleIDs types.Set    `tfsdk:"security_group_rule_ids"`
+}
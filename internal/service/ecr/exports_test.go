@@ -3,8 +3,17 @@
 
 package ecr
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
 // Exports for use in tests only.
 var (
+	ResourceImageCopy                     = newImageCopyResource
 	ResourceLifecyclePolicy               = resourceLifecyclePolicy
 	ResourcePullThroughCacheRule          = resourcePullThroughCacheRule
 	ResourceRegistryPolicy                = resourceRegistryPolicy
@@ -24,3 +33,7 @@ var (
 	FindRepositoryCreationTemplateByRepositoryPrefix = findRepositoryCreationTemplateByRepositoryPrefix
 	FindRepositoryPolicyByRepositoryName             = findRepositoryPolicyByRepositoryName
 )
+
+func FindImageDetailByDigest(ctx context.Context, conn *ecr.Client, registryID, repositoryName, digest string) (*awstypes.ImageDetail, error) {
+	return findImageDetailByDigest(ctx, conn, imageLocation{registryID: aws.String(registryID), repositoryName: repositoryName}, digest)
+}
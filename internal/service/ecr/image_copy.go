@@ -0,0 +1,785 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	imageCopyResourceIDPartCount = 3
+
+	// Maximum number of layer digests in a BatchCheckLayerAvailability request.
+	batchCheckLayerAvailabilityMaxItems = 100
+
+	// Layer part size used when InitiateLayerUpload doesn't return one.
+	defaultLayerPartSize = 20 * 1024 * 1024
+)
+
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIImageIndex      = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIImageManifest   = "application/vnd.oci.image.manifest.v1+json"
+)
+
+// @FrameworkResource("aws_ecr_image_copy", name="Image Copy")
+func newImageCopyResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &imageCopyResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type imageCopyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*imageCopyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ecr_image_copy"
+}
+
+func (r *imageCopyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"added_image_tags": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"child_image_digests": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"image_digest": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_created": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_manifest_media_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_tags": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_uri": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRepositoryName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_image_digest": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z_+.-]+:[0-9A-Fa-f]{32,}$`), "must be an image digest, for example sha256:<hex>"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_registry_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_repository_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *imageCopyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data imageCopyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ECRClient(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	source := imageLocation{
+		registryID:     fwflex.StringFromFramework(ctx, data.SourceRegistryID),
+		repositoryName: data.SourceRepositoryName.ValueString(),
+	}
+	target := imageLocation{
+		registryID:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		repositoryName: data.RepositoryName.ValueString(),
+	}
+	digest := data.SourceImageDigest.ValueString()
+
+	repository, err := findRepository(ctx, conn, &ecr.DescribeRepositoriesInput{
+		RegistryId:      target.registryID,
+		RepositoryNames: []string{target.repositoryName},
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Repository (%s)", target.repositoryName), err.Error())
+
+		return
+	}
+
+	// Record whether the image already exists in the target repository, and with which tags,
+	// so that destroying the resource only removes what it added.
+	var existingTags []string
+	imageCreated := true
+	if imageDetail, err := findImageDetailByDigest(ctx, conn, target, digest); err == nil {
+		existingTags, imageCreated = imageDetail.ImageTags, false
+	} else if !tfresource.NotFound(err) {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s)", digest), err.Error())
+
+		return
+	}
+
+	// Tags in an immutable repository can't be moved. Fail before any layers are copied if a
+	// requested tag already refers to a different image and skip tags that are already in place.
+	tags := fwflex.ExpandFrameworkStringValueSet(ctx, data.ImageTags)
+	if repository.ImageTagMutability == awstypes.ImageTagMutabilityImmutable {
+		var pending []string
+
+		for _, tag := range tags {
+			imageDetail, err := findImageDetailByTag(ctx, conn, target, tag)
+
+			if tfresource.NotFound(err) {
+				pending = append(pending, tag)
+
+				continue
+			}
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s:%s)", target.repositoryName, tag), err.Error())
+
+				return
+			}
+
+			if v := aws.ToString(imageDetail.ImageDigest); v != digest {
+				response.Diagnostics.AddError(fmt.Sprintf("copying ECR Image (%s)", digest), fmt.Sprintf("tag %q already refers to image %s in immutable repository %s", tag, v, target.repositoryName))
+
+				return
+			}
+		}
+
+		tags = pending
+	}
+
+	image, childDigests, err := copyImage(ctx, conn, cleanhttp.DefaultClient(), source, target, digest)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("copying ECR Image (%s) from %s to %s", digest, source.repositoryName, target.repositoryName), err.Error())
+
+		return
+	}
+
+	addedTags := slices.DeleteFunc(slices.Clone(tags), func(tag string) bool {
+		return slices.Contains(existingTags, tag)
+	})
+
+	if len(tags) == 0 {
+		tags = []string{""}
+	}
+	for _, tag := range tags {
+		if err := putImage(ctx, conn, target, image, tag); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting ECR Image (%s) into %s", digest, target.repositoryName), err.Error())
+
+			return
+		}
+	}
+
+	imageDetail, err := findImageDetailByDigest(ctx, conn, target, digest)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s)", digest), err.Error())
+
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(repository.RegistryId), target.repositoryName, digest}, imageCopyResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID ECR Image Copy", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AddedImageTags = fwtypes.SetOfString{SetValue: fwflex.FlattenFrameworkStringValueSetLegacy(ctx, addedTags)}
+	data.ChildImageDigests = fwflex.FlattenFrameworkStringValueListOfString(ctx, childDigests)
+	data.ID = types.StringValue(id)
+	data.ImageCreated = types.BoolValue(imageCreated)
+	data.ImageDigest = types.StringValue(digest)
+	data.ImageManifestMediaType = fwflex.StringToFramework(ctx, imageDetail.ImageManifestMediaType)
+	data.ImageURI = types.StringValue(fmt.Sprintf("%s@%s", aws.ToString(repository.RepositoryUri), digest))
+	data.RegistryID = fwflex.StringToFramework(ctx, repository.RegistryId)
+	data.SourceRegistryID = fwflex.StringToFramework(ctx, image.RegistryId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *imageCopyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data imageCopyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ECRClient(ctx)
+
+	target := imageLocation{
+		registryID:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		repositoryName: data.RepositoryName.ValueString(),
+	}
+	digest := data.ImageDigest.ValueString()
+	imageDetail, err := findImageDetailByDigest(ctx, conn, target, digest)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ImageManifestMediaType = fwflex.StringToFramework(ctx, imageDetail.ImageManifestMediaType)
+
+	// Only track the configured tags, a tag that has since moved to another image shows as a difference.
+	if !data.ImageTags.IsNull() {
+		tags := slices.DeleteFunc(fwflex.ExpandFrameworkStringValueSet(ctx, data.ImageTags), func(tag string) bool {
+			return !slices.Contains(imageDetail.ImageTags, tag)
+		})
+		data.ImageTags.SetValue = fwflex.FlattenFrameworkStringValueSet(ctx, tags)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *imageCopyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data imageCopyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ECRClient(ctx)
+
+	target := imageLocation{
+		registryID:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		repositoryName: data.RepositoryName.ValueString(),
+	}
+	digest := data.ImageDigest.ValueString()
+
+	// Child manifests of an image index are left untagged for lifecycle policies to expire,
+	// as they may be shared with other images in the repository.
+	// An image that already existed in the repository is kept and only the tags added by this resource are removed.
+	var imageIDs []awstypes.ImageIdentifier
+	if data.ImageCreated.ValueBool() {
+		imageIDs = append(imageIDs, awstypes.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+		})
+	} else {
+		for _, tag := range fwflex.ExpandFrameworkStringValueSet(ctx, data.AddedImageTags) {
+			imageIDs = append(imageIDs, awstypes.ImageIdentifier{
+				ImageDigest: aws.String(digest),
+				ImageTag:    aws.String(tag),
+			})
+		}
+	}
+
+	if len(imageIDs) == 0 {
+		return
+	}
+
+	// Removing the last tag from an image deletes the image, so keep its manifest to put it back untagged.
+	var image *awstypes.Image
+	if !data.ImageCreated.ValueBool() {
+		var err error
+		image, err = findImageByDigest(ctx, conn, target, digest)
+
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	input := &ecr.BatchDeleteImageInput{
+		ImageIds:       imageIDs,
+		RegistryId:     target.registryID,
+		RepositoryName: aws.String(target.repositoryName),
+	}
+
+	output, err := conn.BatchDeleteImage(ctx, input)
+
+	if errs.IsA[*awstypes.RepositoryNotFoundException](err) {
+		return
+	}
+
+	if err == nil && output != nil {
+		for _, v := range output.Failures {
+			switch v.FailureCode {
+			// A tag that has since moved to another image is no longer this resource's to remove.
+			case awstypes.ImageFailureCodeImageNotFound, awstypes.ImageFailureCodeImageTagDoesNotMatchDigest:
+				continue
+			}
+
+			err = errors.Join(err, fmt.Errorf("%s: %s", v.FailureCode, aws.ToString(v.FailureReason)))
+		}
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ECR Image (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if image == nil {
+		return
+	}
+
+	if _, err := findImageDetailByDigest(ctx, conn, target, digest); tfresource.NotFound(err) {
+		err = putImage(ctx, conn, target, image, "")
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting ECR Image (%s) into %s", digest, target.repositoryName), err.Error())
+
+			return
+		}
+	} else if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Image (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type imageLocation struct {
+	registryID     *string
+	repositoryName string
+}
+
+type imageManifestDescriptor struct {
+	Digest    string   `json:"digest"`
+	MediaType string   `json:"mediaType"`
+	URLs      []string `json:"urls,omitempty"`
+}
+
+// imageManifest is the subset of an image manifest, or of an image index (manifest list),
+// that's needed to find the content it references.
+type imageManifest struct {
+	Config    *imageManifestDescriptor  `json:"config,omitempty"`
+	Layers    []imageManifestDescriptor `json:"layers,omitempty"`
+	Manifests []imageManifestDescriptor `json:"manifests,omitempty"`
+}
+
+// copyImage copies the content referenced by the specified image from the source repository to the target repository.
+// For an image index (manifest list) each child manifest is copied and put untagged into the target repository.
+// The returned image must then be put into the target repository to complete the copy.
+func copyImage(ctx context.Context, conn *ecr.Client, httpClient *http.Client, source, target imageLocation, digest string) (*awstypes.Image, []string, error) {
+	image, err := findImageByDigest(ctx, conn, source, digest)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading source image: %w", err)
+	}
+
+	var manifest imageManifest
+	if err := json.Unmarshal([]byte(aws.ToString(image.ImageManifest)), &manifest); err != nil {
+		return nil, nil, fmt.Errorf("parsing image manifest (%s): %w", digest, err)
+	}
+
+	var childDigests []string
+	for _, v := range manifest.Manifests {
+		child, err := findImageByDigest(ctx, conn, source, v.Digest)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading source image index manifest (%s): %w", v.Digest, err)
+		}
+
+		var childManifest imageManifest
+		if err := json.Unmarshal([]byte(aws.ToString(child.ImageManifest)), &childManifest); err != nil {
+			return nil, nil, fmt.Errorf("parsing image manifest (%s): %w", v.Digest, err)
+		}
+
+		if err := copyImageLayers(ctx, conn, httpClient, source, target, &childManifest); err != nil {
+			return nil, nil, err
+		}
+
+		if err := putImage(ctx, conn, target, child, ""); err != nil {
+			return nil, nil, fmt.Errorf("putting image index manifest (%s): %w", v.Digest, err)
+		}
+
+		childDigests = append(childDigests, v.Digest)
+	}
+
+	if err := copyImageLayers(ctx, conn, httpClient, source, target, &manifest); err != nil {
+		return nil, nil, err
+	}
+
+	return image, childDigests, nil
+}
+
+// copyImageLayers copies the config and layer blobs referenced by the specified manifest
+// that aren't yet available in the target repository.
+func copyImageLayers(ctx context.Context, conn *ecr.Client, httpClient *http.Client, source, target imageLocation, manifest *imageManifest) error {
+	var digests []string
+
+	if manifest.Config != nil {
+		digests = append(digests, manifest.Config.Digest)
+	}
+	for _, v := range manifest.Layers {
+		// Foreign (non-distributable) layers are pulled from their own URLs.
+		if len(v.URLs) > 0 {
+			continue
+		}
+
+		digests = append(digests, v.Digest)
+	}
+
+	for chunk := range slices.Chunk(digests, batchCheckLayerAvailabilityMaxItems) {
+		input := &ecr.BatchCheckLayerAvailabilityInput{
+			LayerDigests:   chunk,
+			RegistryId:     target.registryID,
+			RepositoryName: aws.String(target.repositoryName),
+		}
+
+		output, err := conn.BatchCheckLayerAvailability(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("checking layer availability: %w", err)
+		}
+
+		var missing []string
+		for _, v := range output.Layers {
+			if v.LayerAvailability != awstypes.LayerAvailabilityAvailable {
+				missing = append(missing, aws.ToString(v.LayerDigest))
+			}
+		}
+		for _, v := range output.Failures {
+			if v.FailureCode == awstypes.LayerFailureCodeMissingLayerDigest {
+				missing = append(missing, aws.ToString(v.LayerDigest))
+
+				continue
+			}
+
+			return fmt.Errorf("checking layer (%s) availability: %s: %s", aws.ToString(v.LayerDigest), v.FailureCode, aws.ToString(v.FailureReason))
+		}
+
+		for _, digest := range missing {
+			if err := copyLayer(ctx, conn, httpClient, source, target, digest); err != nil {
+				return fmt.Errorf("copying layer (%s): %w", digest, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyLayer streams a single blob from the source repository into the target repository.
+func copyLayer(ctx context.Context, conn *ecr.Client, httpClient *http.Client, source, target imageLocation, digest string) error {
+	downloadOutput, err := conn.GetDownloadUrlForLayer(ctx, &ecr.GetDownloadUrlForLayerInput{
+		LayerDigest:    aws.String(digest),
+		RegistryId:     source.registryID,
+		RepositoryName: aws.String(source.repositoryName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("getting download URL: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.ToString(downloadOutput.DownloadUrl), nil)
+
+	if err != nil {
+		return err
+	}
+
+	httpResponse, err := httpClient.Do(httpRequest)
+
+	if err != nil {
+		return fmt.Errorf("downloading: %w", err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading: HTTP status code %d", httpResponse.StatusCode)
+	}
+
+	initiateOutput, err := conn.InitiateLayerUpload(ctx, &ecr.InitiateLayerUploadInput{
+		RegistryId:     target.registryID,
+		RepositoryName: aws.String(target.repositoryName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("initiating upload: %w", err)
+	}
+
+	uploadID := aws.ToString(initiateOutput.UploadId)
+	partSize := aws.ToInt64(initiateOutput.PartSize)
+	if partSize <= 0 {
+		partSize = defaultLayerPartSize
+	}
+	buf := make([]byte, partSize)
+	var firstByte int64
+
+	for {
+		n, err := io.ReadFull(httpResponse.Body, buf)
+
+		if n > 0 {
+			_, err := conn.UploadLayerPart(ctx, &ecr.UploadLayerPartInput{
+				LayerPartBlob:  buf[:n],
+				PartFirstByte:  aws.Int64(firstByte),
+				PartLastByte:   aws.Int64(firstByte + int64(n) - 1),
+				RegistryId:     target.registryID,
+				RepositoryName: aws.String(target.repositoryName),
+				UploadId:       aws.String(uploadID),
+			})
+
+			if err != nil {
+				return fmt.Errorf("uploading part: %w", err)
+			}
+
+			firstByte += int64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("downloading: %w", err)
+		}
+	}
+
+	_, err = conn.CompleteLayerUpload(ctx, &ecr.CompleteLayerUploadInput{
+		LayerDigests:   []string{digest},
+		RegistryId:     target.registryID,
+		RepositoryName: aws.String(target.repositoryName),
+		UploadId:       aws.String(uploadID),
+	})
+
+	if errs.IsA[*awstypes.LayerAlreadyExistsException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("completing upload: %w", err)
+	}
+
+	return nil
+}
+
+// putImage puts the manifest of the specified image into the target repository, optionally with a tag.
+// Putting an image that's already in place isn't an error.
+func putImage(ctx context.Context, conn *ecr.Client, target imageLocation, image *awstypes.Image, tag string) error {
+	input := &ecr.PutImageInput{
+		ImageDigest:            image.ImageId.ImageDigest,
+		ImageManifest:          image.ImageManifest,
+		ImageManifestMediaType: image.ImageManifestMediaType,
+		RegistryId:             target.registryID,
+		RepositoryName:         aws.String(target.repositoryName),
+	}
+
+	if tag != "" {
+		input.ImageTag = aws.String(tag)
+	}
+
+	_, err := conn.PutImage(ctx, input)
+
+	if errs.IsA[*awstypes.ImageAlreadyExistsException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findImageByDigest(ctx context.Context, conn *ecr.Client, location imageLocation, digest string) (*awstypes.Image, error) {
+	input := &ecr.BatchGetImageInput{
+		AcceptedMediaTypes: []string{
+			mediaTypeDockerManifest,
+			mediaTypeDockerManifestList,
+			mediaTypeOCIImageIndex,
+			mediaTypeOCIImageManifest,
+		},
+		ImageIds: []awstypes.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+		RegistryId:     location.registryID,
+		RepositoryName: aws.String(location.repositoryName),
+	}
+
+	output, err := conn.BatchGetImage(ctx, input)
+
+	if errs.IsA[*awstypes.RepositoryNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if v.FailureCode == awstypes.ImageFailureCodeImageNotFound {
+			return nil, &retry.NotFoundError{
+				Message:     aws.ToString(v.FailureReason),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", v.FailureCode, aws.ToString(v.FailureReason))
+	}
+
+	return tfresource.AssertSingleValueResult(output.Images)
+}
+
+func findImageDetailByDigest(ctx context.Context, conn *ecr.Client, location imageLocation, digest string) (*awstypes.ImageDetail, error) {
+	return findImageDetail(ctx, conn, &ecr.DescribeImagesInput{
+		ImageIds: []awstypes.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+		RegistryId:     location.registryID,
+		RepositoryName: aws.String(location.repositoryName),
+	})
+}
+
+func findImageDetailByTag(ctx context.Context, conn *ecr.Client, location imageLocation, tag string) (*awstypes.ImageDetail, error) {
+	return findImageDetail(ctx, conn, &ecr.DescribeImagesInput{
+		ImageIds: []awstypes.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+		RegistryId:     location.registryID,
+		RepositoryName: aws.String(location.repositoryName),
+	})
+}
+
+func findImageDetail(ctx context.Context, conn *ecr.Client, input *ecr.DescribeImagesInput) (*awstypes.ImageDetail, error) {
+	output, err := findImageDetails(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+type imageCopyResourceModel struct {
+	AddedImageTags         fwtypes.SetOfString  `tfsdk:"added_image_tags"`
+	ChildImageDigests      fwtypes.ListOfString `tfsdk:"child_image_digests"`
+	ID                     types.String         `tfsdk:"id"`
+	ImageCreated           types.Bool           `tfsdk:"image_created"`
+	ImageDigest            types.String         `tfsdk:"image_digest"`
+	ImageManifestMediaType types.String         `tfsdk:"image_manifest_media_type"`
+	ImageTags              fwtypes.SetOfString  `tfsdk:"image_tags"`
+	ImageURI               types.String         `tfsdk:"image_uri"`
+	RegistryID             types.String         `tfsdk:"registry_id"`
+	RepositoryName         types.String         `tfsdk:"repository_name"`
+	SourceImageDigest      types.String         `tfsdk:"source_image_digest"`
+	SourceRegistryID       types.String         `tfsdk:"source_registry_id"`
+	SourceRepositoryName   types.String         `tfsdk:"source_repository_name"`
+	Timeouts               timeouts.Value       `tfsdk:"timeouts"`
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImageCopy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+	dataSourceName := "data.aws_ecr_image.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageCopyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "added_image_tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_created", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "image_digest", dataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_manifest_media_type"),
+					resource.TestCheckNoResourceAttr(resourceName, "image_tags"),
+					resource.TestMatchResourceAttr(resourceName, "image_uri", regexache.MustCompile(fmt.Sprintf(`/%s@sha256:`, rName))),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "registry_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source_registry_id", "137112412989"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageCopyExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfecr.ResourceImageCopy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_immutableTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyConfig_immutableTags(rName, "release", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageCopyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "added_image_tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "image_created", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "image_tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "release"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "v1"),
+				),
+			},
+			{
+				// A tag that already refers to the same image in an immutable repository isn't an error.
+				Config: testAccImageCopyConfig_immutableTagsDuplicate(rName, "release", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckImageCopyExists(ctx, resourceName),
+					testAccCheckImageCopyExists(ctx, "aws_ecr_image_copy.duplicate"),
+					resource.TestCheckResourceAttr("aws_ecr_image_copy.duplicate", "added_image_tags.#", "0"),
+					resource.TestCheckResourceAttr("aws_ecr_image_copy.duplicate", "image_created", acctest.CtFalse),
+					resource.TestCheckTypeSetElemAttr("aws_ecr_image_copy.duplicate", "image_tags.*", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageCopyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			// An image that already existed in the repository is left in place.
+			if rs.Type != "aws_ecr_image_copy" || rs.Primary.Attributes["image_created"] != acctest.CtTrue {
+				continue
+			}
+
+			_, err := tfecr.FindImageDetailByDigest(ctx, conn, rs.Primary.Attributes["registry_id"], rs.Primary.Attributes[names.AttrRepositoryName], rs.Primary.Attributes["image_digest"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ECR Image Copy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckImageCopyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
+
+		_, err := tfecr.FindImageDetailByDigest(ctx, conn, rs.Primary.Attributes["registry_id"], rs.Primary.Attributes[names.AttrRepositoryName], rs.Primary.Attributes["image_digest"])
+
+		return err
+	}
+}
+
+func testAccImageCopyConfig_base(rName, tagMutability string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "source" {
+  registry_id     = "137112412989"
+  repository_name = "amazonlinux"
+  image_tag       = "latest"
+}
+
+resource "aws_ecr_repository" "test" {
+  name                 = %[1]q
+  image_tag_mutability = %[2]q
+  force_delete         = true
+}
+`, rName, tagMutability)
+}
+
+func testAccImageCopyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccImageCopyConfig_base(rName, "MUTABLE"), `
+resource "aws_ecr_image_copy" "test" {
+  source_registry_id     = data.aws_ecr_image.source.registry_id
+  source_repository_name = data.aws_ecr_image.source.repository_name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.test.name
+}
+`)
+}
+
+func testAccImageCopyConfig_immutableTags(rName, tag1, tag2 string) string {
+	return acctest.ConfigCompose(testAccImageCopyConfig_base(rName, "IMMUTABLE"), fmt.Sprintf(`
+resource "aws_ecr_image_copy" "test" {
+  source_registry_id     = data.aws_ecr_image.source.registry_id
+  source_repository_name = data.aws_ecr_image.source.repository_name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.test.name
+  image_tags      = [%[1]q, %[2]q]
+}
+`, tag1, tag2))
+}
+
+func testAccImageCopyConfig_immutableTagsDuplicate(rName, tag1, tag2 string) string {
+	return acctest.ConfigCompose(testAccImageCopyConfig_immutableTags(rName, tag1, tag2), fmt.Sprintf(`
+resource "aws_ecr_image_copy" "duplicate" {
+  source_registry_id     = data.aws_ecr_image.source.registry_id
+  source_repository_name = data.aws_ecr_image.source.repository_name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.test.name
+  image_tags      = [%[1]q]
+
+  depends_on = [aws_ecr_image_copy.test]
+}
+`, tag2))
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecr_images", name="Images")
+func newImagesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &imagesDataSource{}, nil
+}
+
+type imagesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*imagesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecr_images"
+}
+
+func (d *imagesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"image_digests": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+			},
+			"image_tag_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"images": framework.DataSourceComputedListOfObjectAttribute[imageDetailModel](ctx),
+			"pushed_after": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrRepositoryName: schema.StringAttribute{
+				Required: true,
+			},
+			"tag_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TagStatus](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *imagesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data imagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECRClient(ctx)
+
+	repositoryName := data.RepositoryName.ValueString()
+	input := &ecr.DescribeImagesInput{
+		RegistryId:     fwflex.StringFromFramework(ctx, data.RegistryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	// The tag status filter is applied locally when looking up images by digest.
+	tagStatus := data.TagStatus.ValueEnum()
+	if digests := fwflex.ExpandFrameworkStringValueList(ctx, data.ImageDigests); len(digests) > 0 {
+		for _, v := range digests {
+			input.ImageIds = append(input.ImageIds, awstypes.ImageIdentifier{
+				ImageDigest: aws.String(v),
+			})
+		}
+	} else if tagStatus != "" {
+		input.Filter = &awstypes.DescribeImagesFilter{
+			TagStatus: tagStatus,
+		}
+	}
+
+	var pushedAfter time.Time
+	if !data.PushedAfter.IsNull() {
+		v, diags := data.PushedAfter.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		pushedAfter = v
+	}
+
+	imageDetails, err := findImageDetails(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Images (%s)", repositoryName), err.Error())
+
+		return
+	}
+
+	repository, err := findRepository(ctx, conn, &ecr.DescribeRepositoriesInput{
+		RegistryId:      input.RegistryId,
+		RepositoryNames: []string{repositoryName},
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECR Repository (%s)", repositoryName), err.Error())
+
+		return
+	}
+
+	tagPrefix := data.ImageTagPrefix.ValueString()
+	imageDetails = slices.DeleteFunc(imageDetails, func(v awstypes.ImageDetail) bool {
+		switch tagStatus {
+		case awstypes.TagStatusTagged:
+			if len(v.ImageTags) == 0 {
+				return true
+			}
+		case awstypes.TagStatusUntagged:
+			if len(v.ImageTags) > 0 {
+				return true
+			}
+		}
+
+		if tagPrefix != "" && !slices.ContainsFunc(v.ImageTags, func(tag string) bool {
+			return strings.HasPrefix(tag, tagPrefix)
+		}) {
+			return true
+		}
+
+		if !pushedAfter.IsZero() && !aws.ToTime(v.ImagePushedAt).After(pushedAfter) {
+			return true
+		}
+
+		return false
+	})
+
+	// Most recently pushed first.
+	slices.SortStableFunc(imageDetails, func(a, b awstypes.ImageDetail) int {
+		return aws.ToTime(b.ImagePushedAt).Compare(aws.ToTime(a.ImagePushedAt))
+	})
+
+	images := make([]imageDetailModel, 0, len(imageDetails))
+	for _, v := range imageDetails {
+		images = append(images, imageDetailModel{
+			ImageDigest:            fwflex.StringToFramework(ctx, v.ImageDigest),
+			ImageManifestMediaType: fwflex.StringToFramework(ctx, v.ImageManifestMediaType),
+			ImagePushedAt:          timetypes.NewRFC3339TimeValue(aws.ToTime(v.ImagePushedAt)),
+			ImageSizeInBytes:       fwflex.Int64ToFramework(ctx, v.ImageSizeInBytes),
+			ImageTags:              fwflex.FlattenFrameworkStringValueListOfString(ctx, v.ImageTags),
+			ImageURI:               types.StringValue(fmt.Sprintf("%s@%s", aws.ToString(repository.RepositoryUri), aws.ToString(v.ImageDigest))),
+		})
+	}
+
+	data.Images = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, images)
+	data.RegistryID = fwflex.StringToFramework(ctx, repository.RegistryId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type imagesDataSourceModel struct {
+	ImageDigests   fwtypes.ListOfString                              `tfsdk:"image_digests"`
+	ImageTagPrefix types.String                                      `tfsdk:"image_tag_prefix"`
+	Images         fwtypes.ListNestedObjectValueOf[imageDetailModel] `tfsdk:"images"`
+	PushedAfter    timetypes.RFC3339                                 `tfsdk:"pushed_after"`
+	RegistryID     types.String                                      `tfsdk:"registry_id"`
+	RepositoryName types.String                                      `tfsdk:"repository_name"`
+	TagStatus      fwtypes.StringEnum[awstypes.TagStatus]            `tfsdk:"tag_status"`
+}
+
+type imageDetailModel struct {
+	ImageDigest            types.String         `tfsdk:"image_digest"`
+	ImageManifestMediaType types.String         `tfsdk:"image_manifest_media_type"`
+	ImagePushedAt          timetypes.RFC3339    `tfsdk:"image_pushed_at"`
+	ImageSizeInBytes       types.Int64          `tfsdk:"image_size_in_bytes"`
+	ImageTags              fwtypes.ListOfString `tfsdk:"image_tags"`
+	ImageURI               types.String         `tfsdk:"image_uri"`
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_basic(registry, repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "images.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_pushed_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_uri"),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImagesDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo, tag := "137112412989", "amazonlinux", "latest"
+	dataSourceByDigest := "data.aws_ecr_images.by_digest"
+	dataSourceByTagPrefix := "data.aws_ecr_images.by_tag_prefix"
+	dataSourceByPushedAfter := "data.aws_ecr_images.by_pushed_after"
+	imageDataSourceName := "data.aws_ecr_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_filters(registry, repo, tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceByDigest, "images.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceByDigest, "images.0.image_digest", imageDataSourceName, "image_digest"),
+					resource.TestCheckResourceAttrPair(dataSourceByDigest, "images.0.image_uri", imageDataSourceName, "image_uri"),
+					resource.TestCheckTypeSetElemAttr(dataSourceByDigest, "images.0.image_tags.*", tag),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceByTagPrefix, "images.#", 1),
+					resource.TestCheckResourceAttr(dataSourceByPushedAfter, "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_basic(registry, repo string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_status      = "TAGGED"
+}
+`, registry, repo)
+}
+
+func testAccImagesDataSourceConfig_filters(registry, repo, tag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  image_tag       = %[3]q
+}
+
+data "aws_ecr_images" "by_digest" {
+  registry_id     = data.aws_ecr_image.test.registry_id
+  repository_name = data.aws_ecr_image.test.repository_name
+  image_digests   = [data.aws_ecr_image.test.image_digest]
+}
+
+data "aws_ecr_images" "by_tag_prefix" {
+  registry_id      = data.aws_ecr_image.test.registry_id
+  repository_name  = data.aws_ecr_image.test.repository_name
+  image_tag_prefix = substr(%[3]q, 0, 3)
+}
+
+data "aws_ecr_images" "by_pushed_after" {
+  registry_id     = data.aws_ecr_image.test.registry_id
+  repository_name = data.aws_ecr_image.test.repository_name
+  image_digests   = [data.aws_ecr_image.test.image_digest]
+  pushed_after    = timeadd(timestamp(), "24h")
+}
+`, registry, repo, tag)
+}
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newImagesDataSource,
+			TypeName: "aws_ecr_images",
+			Name:     "Images",
+		},
 		{
 			Factory:  newLifecyclePolicyDocumentDataSource,
 			TypeName: "aws_ecr_lifecycle_policy_document",
@@ -36,6 +41,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_ecr_account_setting",
 			Name:     "Account Setting",
 		},
+		{
+			Factory:  newImageCopyResource,
+			TypeName: "aws_ecr_image_copy",
+			Name:     "Image Copy",
+		},
 	}
 }
 
@@ -9,13 +9,16 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,7 +30,9 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // @SDKResource("aws_eks_addon", name="Add-On")
@@ -43,7 +48,10 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAddonConfigurationValuesCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -370,6 +378,68 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// resourceAddonConfigurationValuesCustomizeDiff validates configuration_values against the
+// configuration schema published for the add-on version so that invalid values are reported
+// when planning rather than when the add-on is created or updated.
+func resourceAddonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	if !d.NewValueKnown("addon_name") || !d.NewValueKnown("addon_version") || !d.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonName, addonVersion, configurationValues := d.Get("addon_name").(string), d.Get("addon_version").(string), d.Get("configuration_values").(string)
+
+	// The default version is only chosen when the add-on is created.
+	if addonVersion == "" || configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	configurationSchema, err := findAddonConfigurationSchemaByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		// The configuration values are still validated when the add-on is created or updated.
+		log.Printf("[WARN] Reading EKS Add-On (%s) version (%s) configuration schema: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	// A path error is reported as a diagnostic on the configuration_values attribute,
+	// with each location that doesn't match the schema on its own line.
+	if err := validateAddonConfigurationValues(configurationSchema, configurationValues); err != nil {
+		return cty.GetAttrPath("configuration_values").NewErrorf("configuration_values do not match the EKS Add-On (%s) version (%s) configuration schema:\n%s", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
+// validateAddonConfigurationValues validates JSON or YAML configuration values against an add-on configuration schema.
+// An error is returned for each location in the configuration values that doesn't match the schema.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	var document any
+
+	if err := tfyaml.DecodeFromString(configurationValues, &document); err != nil {
+		return fmt.Errorf("decoding configuration values: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewGoLoader(document))
+
+	if err != nil {
+		return fmt.Errorf("validating configuration values: %w", err)
+	}
+
+	var errs []error
+
+	for _, v := range result.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", v.Field(), v.Description()))
+	}
+
+	return errors.Join(errs...)
+}
+
 func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
 	if len(tfList) == 0 {
 		return nil
@@ -457,6 +527,44 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+// Configuration schemas don't change for a given add-on version, so they are cached for the provider run.
+// The API isn't called while holding a lock, so concurrent first reads of the same version may each call it.
+var addonConfigurationSchemas sync.Map
+
+func findAddonConfigurationSchemaByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (string, error) {
+	key := addonName + "/" + addonVersion
+
+	if v, ok := addonConfigurationSchemas.Load(key); ok {
+		return v.(string), nil
+	}
+
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || aws.ToString(output.ConfigurationSchema) == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	v, _ := addonConfigurationSchemas.LoadOrStore(key, aws.ToString(output.ConfigurationSchema))
+
+	return v.(string), nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -6,6 +6,7 @@ package eks_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -21,6 +22,105 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$ref": "#/definitions/Coredns",
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "definitions": {
+    "Coredns": {
+      "additionalProperties": false,
+      "properties": {
+        "replicaCount": {"type": "integer"},
+        "resources": {"$ref": "#/definitions/Resources"}
+      },
+      "type": "object"
+    },
+    "Resources": {
+      "additionalProperties": false,
+      "properties": {
+        "limits": {
+          "additionalProperties": false,
+          "properties": {
+            "cpu": {"type": "string"},
+            "memory": {"type": "string"}
+          },
+          "type": "object"
+        }
+      },
+      "type": "object"
+    }
+  }
+}`
+
+	testCases := map[string]struct {
+		configurationValues string
+		expectedErrors      []string
+	}{
+		"empty": {
+			configurationValues: `{}`,
+		},
+		"valid JSON": {
+			configurationValues: `{"replicaCount": 2, "resources": {"limits": {"memory": "100Mi"}}}`,
+		},
+		"valid YAML": {
+			configurationValues: "replicaCount: 2\nresources:\n  limits:\n    cpu: 100m\n",
+		},
+		"invalid JSON": {
+			configurationValues: `{"replicaCount": "two", "resources": {"limits": {"memory": 100}}}`,
+			expectedErrors: []string{
+				"replicaCount: Invalid type. Expected: integer, given: string",
+				"resources.limits.memory: Invalid type. Expected: string, given: integer",
+			},
+		},
+		"invalid YAML": {
+			configurationValues: "replicaCount: 2\nresources:\n  requests:\n    cpu: 100m\n",
+			expectedErrors: []string{
+				"resources: Additional property requests is not allowed",
+			},
+		},
+		"unknown property": {
+			configurationValues: `{"podLabels": {}}`,
+			expectedErrors: []string{
+				"(root): Additional property podLabels is not allowed",
+			},
+		},
+		"not YAML": {
+			configurationValues: `{"replicaCount": 2`,
+			expectedErrors: []string{
+				"decoding configuration values",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfeks.ValidateAddonConfigurationValues(configurationSchema, testCase.configurationValues)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			for _, expected := range testCase.expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain %q, got %q", expected, err)
+				}
+			}
+		})
+	}
+}
+
 func TestAccEKSAddon_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var addon types.Addon
@@ -346,7 +446,7 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 			},
 			{
 				Config:      testAccAddonConfig_configurationValues(rName, addonName, addonVersion, invalidConfigurationValues, string(types.ResolveConflictsOverwrite)),
-				ExpectError: regexache.MustCompile(`InvalidParameterException: ConfigurationValue provided in request is not supported`),
+				ExpectError: regexache.MustCompile(`configuration_values do not match the EKS Add-On \(vpc-cni\) version \(v1.17.1-eksbuild.1\) configuration schema`),
 			},
 		},
 	})
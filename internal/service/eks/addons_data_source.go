@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_addons", name="Add-Ons")
+func dataSourceAddons() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAddonsRead,
+
+		Schema: map[string]*schema.Schema{
+			"addons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addon_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"addon_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"default_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"kubernetes_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAddonsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	cluster, err := findClusterByName(ctx, conn, clusterName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", clusterName, err)
+	}
+
+	kubernetesVersion := aws.ToString(cluster.Version)
+
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var addonNames []string
+	pages := eks.NewListAddonsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing EKS Add-Ons (%s): %s", clusterName, err)
+		}
+
+		addonNames = append(addonNames, page.Addons...)
+	}
+
+	var tfList []interface{}
+
+	for _, addonName := range addonNames {
+		addon, err := findAddonByTwoPartKey(ctx, conn, clusterName, addonName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s): %s", AddonCreateResourceID(clusterName, addonName), err)
+		}
+
+		versions, err := findAddonVersionsByTwoPartKey(ctx, conn, addonName, kubernetesVersion)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) versions for Kubernetes version (%s): %s", addonName, kubernetesVersion, err)
+		}
+
+		var availableVersions []string
+		var defaultVersion string
+		for _, v := range versions {
+			availableVersions = append(availableVersions, aws.ToString(v.AddonVersion))
+
+			for _, compatibility := range v.Compatibilities {
+				if compatibility.DefaultVersion && aws.ToString(compatibility.ClusterVersion) == kubernetesVersion {
+					defaultVersion = aws.ToString(v.AddonVersion)
+				}
+			}
+		}
+
+		tfMap := map[string]interface{}{
+			"addon_name":         aws.ToString(addon.AddonName),
+			"addon_version":      aws.ToString(addon.AddonVersion),
+			names.AttrARN:        aws.ToString(addon.AddonArn),
+			"available_versions": availableVersions,
+			"default_version":    defaultVersion,
+			names.AttrStatus:     string(addon.Status),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(clusterName)
+	if err := d.Set("addons", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting addons: %s", err)
+	}
+	d.Set(names.AttrClusterName, clusterName)
+	d.Set("kubernetes_version", kubernetesVersion)
+
+	return diags
+}
+
+// findAddonVersionsByTwoPartKey returns the versions of an add-on that are compatible with a Kubernetes version, most recent first.
+func findAddonVersionsByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, kubernetesVersion string) ([]types.AddonVersionInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+	var output []types.AddonVersionInfo
+
+	pages := eks.NewDescribeAddonVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Addons {
+			output = append(output, v.AddonVersions...)
+		}
+	}
+
+	return output, nil
+}
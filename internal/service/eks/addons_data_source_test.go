@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAddonsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_addons.test"
+	resourceName := "aws_eks_addon.test"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonsDataSourceConfig_basic(rName, addonName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "addons.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "addon_name", dataSourceResourceName, "addons.0.addon_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "addon_version", dataSourceResourceName, "addons.0.addon_version"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceResourceName, "addons.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "addons.0.available_versions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceResourceName, "addons.0.default_version"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "addons.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, dataSourceResourceName, names.AttrClusterName),
+					resource.TestCheckResourceAttrPair("aws_eks_cluster.test", names.AttrVersion, dataSourceResourceName, "kubernetes_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddonsDataSourceConfig_basic(rName, addonName string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_basic(rName, addonName), `
+data "aws_eks_addons" "test" {
+  cluster_name = aws_eks_addon.test.cluster_name
+}
+`)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -431,6 +432,23 @@ func resourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"upgrade_readiness_check": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocking_statuses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.InsightStatusValue](),
+							},
+						},
+					},
+				},
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -688,9 +706,42 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Do any version update first.
 	if d.HasChange(names.AttrVersion) {
+		version := d.Get(names.AttrVersion).(string)
+
+		if v, ok := d.GetOk("upgrade_readiness_check"); ok && len(v.([]interface{})) > 0 {
+			var blockingStatuses []types.InsightStatusValue
+			if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+				blockingStatuses = flex.ExpandStringyValueSet[types.InsightStatusValue](tfMap["blocking_statuses"].(*schema.Set))
+			}
+
+			insights, err := findUpgradeReadinessInsightsByTwoPartKey(ctx, conn, d.Id(), version)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) upgrade readiness insights: %s", d.Id(), err)
+			}
+
+			var errs []error
+
+			for _, insight := range insights {
+				if insight.InsightStatus == nil || insight.InsightStatus.Status == types.InsightStatusValuePassing {
+					continue
+				}
+
+				if slices.Contains(blockingStatuses, insight.InsightStatus.Status) {
+					errs = append(errs, insightError(insight))
+				} else {
+					diags = sdkdiag.AppendWarningf(diags, "EKS Cluster (%s) upgrade readiness for version %s: %s", d.Id(), version, insightError(insight))
+				}
+			}
+
+			if err := errors.Join(errs...); err != nil {
+				return sdkdiag.AppendErrorf(diags, "EKS Cluster (%s) is not ready to upgrade to version %s:\n%s", d.Id(), version, err)
+			}
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(d.Id()),
-			Version: aws.String(d.Get(names.AttrVersion).(string)),
+			Version: aws.String(version),
 		}
 
 		output, err := conn.UpdateClusterVersion(ctx, input)
@@ -1003,6 +1054,43 @@ func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name,
 	return output.Update, nil
 }
 
+// findUpgradeReadinessInsightsByTwoPartKey returns the upgrade readiness insights for upgrading a cluster to the specified Kubernetes version.
+func findUpgradeReadinessInsightsByTwoPartKey(ctx context.Context, conn *eks.Client, name, version string) ([]types.InsightSummary, error) {
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(name),
+		Filter: &types.InsightsFilter{
+			Categories:         []types.Category{types.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+		},
+	}
+
+	return findInsights(ctx, conn, input)
+}
+
+func findInsights(ctx context.Context, conn *eks.Client, input *eks.ListInsightsInput) ([]types.InsightSummary, error) {
+	var output []types.InsightSummary
+
+	pages := eks.NewListInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Insights...)
+	}
+
+	return output, nil
+}
+
 func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
@@ -1094,6 +1182,17 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 	return nil, err
 }
 
+func insightError(apiObject types.InsightSummary) error {
+	var status types.InsightStatusValue
+	var reason string
+	if v := apiObject.InsightStatus; v != nil {
+		status = v.Status
+		reason = aws.ToString(v.Reason)
+	}
+
+	return fmt.Errorf("%s (%s): %s", aws.ToString(apiObject.Name), status, reason)
+}
+
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
 	if len(tfList) == 0 {
 		return nil
@@ -23,6 +23,10 @@ func resources_Values() []string {
 
 const (
 	propagationTimeout = 2 * time.Minute
+
+	// Delay, per node that can be unavailable at a time, before retrying a node group version update that failed to evict pods.
+	nodeGroupPodEvictionRetryDelay    = 1 * time.Minute
+	nodeGroupPodEvictionMaxRetryDelay = 10 * time.Minute
 )
 
 const (
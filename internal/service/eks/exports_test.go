@@ -23,4 +23,7 @@ var (
 	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
 	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
 	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+	PodEvictionRetryDelay                      = podEvictionRetryDelay
+	UpdateHasErrorCode                         = updateHasErrorCode
+	ValidateAddonConfigurationValues           = validateAddonConfigurationValues
 )
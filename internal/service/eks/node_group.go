@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -311,6 +312,11 @@ func resourceNodeGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_pod_disruption_budgets": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"force_update_version"},
+			},
 		},
 	}
 }
@@ -521,16 +527,55 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.Version = aws.String(v.(string))
 		}
 
-		output, err := conn.UpdateNodegroupVersion(ctx, input)
+		waitForPDBs := d.Get("wait_for_pod_disruption_budgets").(bool)
+		deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) version: %s", d.Id(), err)
-		}
+		for attempt := 1; ; attempt++ {
+			output, err := conn.UpdateNodegroupVersion(ctx, input)
 
-		updateID := aws.ToString(output.Update.Id)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EKS Node Group (%s) version: %s", d.Id(), err)
+			}
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+			updateID := aws.ToString(output.Update.Id)
+
+			update, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, deadline.Remaining())
+
+			if err == nil {
+				if attempt > 1 {
+					diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) version update (%s) attempt %d: %s", d.Id(), updateID, attempt, update.Status)
+				}
+
+				break
+			}
+
+			// Pods protected by pod disruption budgets couldn't be evicted from the nodes being replaced.
+			// Nodes that were replaced stay replaced, so retry the update once the budgets may allow the remaining pods to be evicted.
+			if !waitForPDBs || update == nil || !updateHasErrorCode(update, types.ErrorCodePodEvictionFailure) {
+				return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+			}
+
+			nodeGroup, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EKS Node Group (%s): %s", d.Id(), err)
+			}
+
+			delay := podEvictionRetryDelay(nodeGroup)
+
+			if deadline.Remaining() < delay {
+				return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s) attempt %d: %s: %s", d.Id(), updateID, attempt, update.Status, errorDetailsError(update.Errors))
+			}
+
+			diags = sdkdiag.AppendWarningf(diags, "EKS Node Group (%s) version update (%s) attempt %d: %s: %s; retrying in %s", d.Id(), updateID, attempt, update.Status, errorDetailsError(update.Errors), delay)
+
+			select {
+			case <-ctx.Done():
+				return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update: %s", d.Id(), ctx.Err())
+			case <-time.After(delay):
+			}
+
+			input.ClientRequestToken = aws.String(id.UniqueId())
 		}
 	}
 
@@ -759,6 +804,29 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 	return nil, err
 }
 
+func updateHasErrorCode(apiObject *types.Update, code types.ErrorCode) bool {
+	return slices.ContainsFunc(apiObject.Errors, func(v types.ErrorDetail) bool {
+		return v.ErrorCode == code
+	})
+}
+
+// podEvictionRetryDelay returns how long to wait before retrying a node group version update that failed to evict pods.
+// Each attempt replaces as many nodes at a time as the node group's update configuration allows,
+// so the pods evicted from each of those nodes are given time to be rescheduled before the next attempt.
+func podEvictionRetryDelay(apiObject *types.Nodegroup) time.Duration {
+	maxUnavailable := int32(1)
+
+	if v := apiObject.UpdateConfig; v != nil {
+		if v.MaxUnavailable != nil {
+			maxUnavailable = aws.ToInt32(v.MaxUnavailable)
+		} else if v.MaxUnavailablePercentage != nil && apiObject.ScalingConfig != nil {
+			maxUnavailable = (aws.ToInt32(apiObject.ScalingConfig.DesiredSize)*aws.ToInt32(v.MaxUnavailablePercentage) + 99) / 100
+		}
+	}
+
+	return min(time.Duration(max(maxUnavailable, 1))*nodeGroupPodEvictionRetryDelay, nodeGroupPodEvictionMaxRetryDelay)
+}
+
 func issueError(apiObject types.Issue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,6 +26,116 @@ func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.EKSServiceID, testAccErrorCheckSkip)
 }
 
+func TestPodEvictionRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		nodeGroup *types.Nodegroup
+		expected  time.Duration
+	}{
+		"no update config": {
+			nodeGroup: &types.Nodegroup{},
+			expected:  1 * time.Minute,
+		},
+		"max unavailable": {
+			nodeGroup: &types.Nodegroup{
+				UpdateConfig: &types.NodegroupUpdateConfig{
+					MaxUnavailable: aws.Int32(3),
+				},
+			},
+			expected: 3 * time.Minute,
+		},
+		"max unavailable capped": {
+			nodeGroup: &types.Nodegroup{
+				UpdateConfig: &types.NodegroupUpdateConfig{
+					MaxUnavailable: aws.Int32(50),
+				},
+			},
+			expected: 10 * time.Minute,
+		},
+		"max unavailable percentage": {
+			nodeGroup: &types.Nodegroup{
+				ScalingConfig: &types.NodegroupScalingConfig{
+					DesiredSize: aws.Int32(10),
+				},
+				UpdateConfig: &types.NodegroupUpdateConfig{
+					MaxUnavailablePercentage: aws.Int32(25),
+				},
+			},
+			expected: 3 * time.Minute,
+		},
+		"max unavailable percentage no nodes": {
+			nodeGroup: &types.Nodegroup{
+				ScalingConfig: &types.NodegroupScalingConfig{
+					DesiredSize: aws.Int32(0),
+				},
+				UpdateConfig: &types.NodegroupUpdateConfig{
+					MaxUnavailablePercentage: aws.Int32(25),
+				},
+			},
+			expected: 1 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfeks.PodEvictionRetryDelay(testCase.nodeGroup), testCase.expected; got != want {
+				t.Errorf("PodEvictionRetryDelay() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateHasErrorCode(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		update   *types.Update
+		expected bool
+	}{
+		"no errors": {
+			update:   &types.Update{Status: types.UpdateStatusFailed},
+			expected: false,
+		},
+		"pod eviction failure": {
+			update: &types.Update{
+				Errors: []types.ErrorDetail{
+					{
+						ErrorCode:    types.ErrorCodePodEvictionFailure,
+						ErrorMessage: aws.String("Reached max retries while trying to evict pods from nodes in node group"),
+						ResourceIds:  []string{"ip-10-0-1-1.ec2.internal"},
+					},
+				},
+				Status: types.UpdateStatusFailed,
+			},
+			expected: true,
+		},
+		"other failure": {
+			update: &types.Update{
+				Errors: []types.ErrorDetail{
+					{
+						ErrorCode: types.ErrorCodeNodeCreationFailure,
+					},
+				},
+				Status: types.UpdateStatusFailed,
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfeks.UpdateHasErrorCode(testCase.update, types.ErrorCodePodEvictionFailure), testCase.expected; got != want {
+				t.Errorf("UpdateHasErrorCode() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccEKSNodeGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var nodeGroup types.Nodegroup
@@ -280,6 +391,43 @@ func TestAccEKSNodeGroup_forceUpdateVersion(t *testing.T) {
 	})
 }
 
+func TestAccEKSNodeGroup_waitForPodDisruptionBudgets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var nodeGroup1 types.Nodegroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeGroupConfig_waitForPodDisruptionBudgets(rName, clusterVersionUpgradeInitial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeInitial),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_pod_disruption_budgets", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_pod_disruption_budgets"},
+			},
+			{
+				Config: testAccNodeGroupConfig_waitForPodDisruptionBudgets(rName, clusterVersionUpgradeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeGroupExists(ctx, resourceName, &nodeGroup1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeUpdated),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSNodeGroup_InstanceTypes_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	var nodeGroup1 types.Nodegroup
@@ -1427,6 +1575,35 @@ resource "aws_eks_node_group" "test" {
 `, rName))
 }
 
+func testAccNodeGroupConfig_waitForPodDisruptionBudgets(rName, version string) string {
+	return acctest.ConfigCompose(testAccNodeGroupConfig_versionBase(rName, version), fmt.Sprintf(`
+resource "aws_eks_node_group" "test" {
+  cluster_name                    = aws_eks_cluster.test.name
+  node_group_name                 = %[1]q
+  node_role_arn                   = aws_iam_role.node.arn
+  subnet_ids                      = aws_subnet.test[*].id
+  version                         = aws_eks_cluster.test.version
+  wait_for_pod_disruption_budgets = true
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  update_config {
+    max_unavailable = 1
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+`, rName))
+}
+
 func testAccNodeGroupConfig_instanceTypesMultiple(rName, instanceTypes string) string {
 	return acctest.ConfigCompose(
 		testAccNodeGroupConfig_base(rName),
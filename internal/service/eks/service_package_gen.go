@@ -58,6 +58,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_eks_addon_version",
 			Name:     "Add-On Version",
 		},
+		{
+			Factory:  dataSourceAddons,
+			TypeName: "aws_eks_addons",
+			Name:     "Add-Ons",
+		},
 		{
 			Factory:  dataSourceCluster,
 			TypeName: "aws_eks_cluster",
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_event_connection",
 			Name:     "Connection",
 		},
+		{
+			Factory:  dataSourceRule,
+			TypeName: "aws_cloudwatch_event_rule",
+			Name:     "Rule",
+		},
 		{
 			Factory:  dataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
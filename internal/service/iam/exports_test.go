@@ -57,5 +57,6 @@ var (
 	FindUserPoliciesByName              = findUserPoliciesByName
 	FindUserPolicyAttachmentsByName     = findUserPolicyAttachmentsByName
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
+	LintPolicyDocument                  = lintPolicyDocument
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 )
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_policies", name="Policies")
+func dataSourcePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"only_attached": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"path_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"attachment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPath: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrScope: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(awstypes.PolicyScopeTypeLocal),
+				ValidateDiagFunc: enum.Validate[awstypes.PolicyScopeType](),
+			},
+		},
+	}
+}
+
+func dataSourcePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	input := &iam.ListPoliciesInput{
+		OnlyAttached: d.Get("only_attached").(bool),
+		Scope:        awstypes.PolicyScopeType(d.Get(names.AttrScope).(string)),
+	}
+
+	if v, ok := d.GetOk("path_prefix"); ok {
+		input.PathPrefix = aws.String(v.(string))
+	}
+
+	policies, err := findPolicies(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policies: %s", err)
+	}
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		re := regexache.MustCompile(v.(string))
+		policies = tfslices.Filter(policies, func(v awstypes.Policy) bool {
+			return re.MatchString(aws.ToString(v.PolicyName))
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+
+	var arns, nms []string
+	var tfList []interface{}
+
+	for _, v := range policies {
+		arns = append(arns, aws.ToString(v.Arn))
+		nms = append(nms, aws.ToString(v.PolicyName))
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:      aws.ToString(v.Arn),
+			"attachment_count": int(aws.ToInt32(v.AttachmentCount)),
+			names.AttrName:     aws.ToString(v.PolicyName),
+			names.AttrPath:     aws.ToString(v.Path),
+			"update_date":      aws.ToTime(v.UpdateDate).Format(time.RFC3339),
+		})
+	}
+
+	if err := d.Set(names.AttrARNs, arns); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting arns: %s", err)
+	}
+
+	if err := d.Set(names.AttrNames, nms); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting names: %s", err)
+	}
+
+	if err := d.Set("policies", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policies: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPoliciesDataSource_pathPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	dataSourceName := "data.aws_iam_policies.test"
+	resourceName := "aws_iam_policy.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_pathPrefix(rName, rPathPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.attachment_count", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policies.0.path", resourceName, names.AttrPath),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policies.0.update_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_onlyAttached(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rPathPrefix := sdkacctest.RandomWithPrefix("tf-acc-path")
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_onlyAttached(rName, rPathPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName+"-0"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.attachment_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPoliciesDataSource_nameRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_nameRegex(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig_base(rName, pathPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  path = "/%[2]s/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:DescribeAccountAttributes"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName, pathPrefix)
+}
+
+func testAccPoliciesDataSourceConfig_pathPrefix(rName, pathPrefix string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfig_base(rName, pathPrefix), fmt.Sprintf(`
+data "aws_iam_policies" "test" {
+  path_prefix = "/%[1]s/"
+
+  depends_on = [aws_iam_policy.test]
+}
+`, pathPrefix))
+}
+
+func testAccPoliciesDataSourceConfig_onlyAttached(rName, pathPrefix string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfig_base(rName, pathPrefix), testAccRoleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test[0].arn
+}
+
+data "aws_iam_policies" "test" {
+  only_attached = true
+  path_prefix   = "/%[1]s/"
+
+  depends_on = [aws_iam_policy.test, aws_iam_role_policy_attachment.test]
+}
+`, pathPrefix))
+}
+
+func testAccPoliciesDataSourceConfig_nameRegex(rName string) string {
+	return acctest.ConfigCompose(testAccPoliciesDataSourceConfig_base(rName, "test"), fmt.Sprintf(`
+data "aws_iam_policies" "test" {
+  name_regex  = "^%[1]s-1$"
+  path_prefix = "/test/"
+
+  depends_on = [aws_iam_policy.test]
+}
+`, rName))
+}
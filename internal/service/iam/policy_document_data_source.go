@@ -11,336 +11,471 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	policyDocumentVersion20081017 = "2008-10-17"
+	policyDocumentVersion20121017 = "2012-10-17"
+)
+
+const (
+	policyDocumentTypeIdentity = "identity"
+	policyDocumentTypeResource = "resource"
+)
+
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
-// @SDKDataSource("aws_iam_policy_document", name="Policy Document")
-func dataSourcePolicyDocument() *schema.Resource {
-	return &schema.Resource{
-		ReadWithoutTimeout: dataSourcePolicyDocumentRead,
-
-		SchemaFunc: func() map[string]*schema.Schema {
-			principalsSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeSet,
-					Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"identifiers": {
-								Type:     schema.TypeSet,
-								Required: true,
-								Elem: &schema.Schema{
-									Type: schema.TypeString,
-								},
-							},
-							names.AttrType: {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-						},
+// @FrameworkDataSource("aws_iam_policy_document", name="Policy Document")
+func newPolicyDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyDocumentDataSource{}, nil
+}
+
+type policyDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *policyDocumentDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_policy_document"
+}
+
+func (d *policyDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	principalsBlock := func() schema.SetNestedBlock {
+		return schema.SetNestedBlock{
+			CustomType: fwtypes.NewSetNestedObjectTypeOf[policyDocumentStatementPrincipalModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"identifiers": schema.SetAttribute{
+						CustomType: fwtypes.SetOfStringType,
+						Required:   true,
 					},
-				}
-			}
-			setOfStringSchema := func() *schema.Schema {
-				return &schema.Schema{
-					Type:     schema.TypeSet,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type: schema.TypeString,
+					names.AttrType: schema.StringAttribute{
+						Required: true,
 					},
-				}
-			}
-
-			return map[string]*schema.Schema{
-				names.AttrJSON: {
-					Type:     schema.TypeString,
-					Computed: true,
 				},
-				"minified_json": {
-					Type:     schema.TypeString,
-					Computed: true,
+			},
+		}
+	}
+	setOfStringAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType: fwtypes.SetOfStringType,
+			Optional:   true,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"minified_json": schema.StringAttribute{
+				Computed: true,
+			},
+			// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
+			"override_json": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Not used",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(0),
 				},
-				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
-				"override_json": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: validation.StringIsEmpty,
-					Deprecated:   "Not used",
+			},
+			"override_policy_documents": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			"policy_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"policy_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyDocumentTypeIdentity, policyDocumentTypeResource),
 				},
-				"override_policy_documents": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type:         schema.TypeString,
-						ValidateFunc: validation.StringIsJSON,
-					},
+			},
+			// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
+			"source_json": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Not used",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(0),
 				},
-				"policy_id": {
-					Type:     schema.TypeString,
-					Optional: true,
+			},
+			"source_policy_documents": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			"statements": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementsModel](ctx),
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyDocumentVersion20081017, policyDocumentVersion20121017),
 				},
-				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
-				"source_json": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: validation.StringIsEmpty,
-					Deprecated:   "Not used",
-				},
-				"source_policy_documents": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type:         schema.TypeString,
-						ValidateFunc: validation.StringIsJSON,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[policyDocumentStatementModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrActions: setOfStringAttribute(),
+						"effect": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Allow", "Deny"),
+							},
+						},
+						"not_actions":       setOfStringAttribute(),
+						"not_resources":     setOfStringAttribute(),
+						names.AttrResources: setOfStringAttribute(),
+						// Because policy documents are widely used outside IAM, we don't enforce
+						// IAM validation rules requiring alphanumeric and no spaces.
+						"sid": schema.StringAttribute{
+							Optional: true,
+						},
 					},
-				},
-				"statement": {
-					Type:     schema.TypeList,
-					Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrActions: setOfStringSchema(),
-							names.AttrCondition: {
-								Type:     schema.TypeSet,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"test": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										names.AttrValues: {
-											Type:     schema.TypeList,
-											Required: true,
-											Elem: &schema.Schema{
-												Type: schema.TypeString,
-											},
-										},
-										"variable": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
+					Blocks: map[string]schema.Block{
+						names.AttrCondition: schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[policyDocumentStatementConditionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType: fwtypes.ListOfStringType,
+										Required:   true,
+									},
+									"variable": schema.StringAttribute{
+										Required: true,
 									},
 								},
 							},
-							"effect": {
-								Type:         schema.TypeString,
-								Optional:     true,
-								Default:      "Allow",
-								ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
-							},
-							"not_actions":       setOfStringSchema(),
-							"not_principals":    principalsSchema(),
-							"not_resources":     setOfStringSchema(),
-							"principals":        principalsSchema(),
-							names.AttrResources: setOfStringSchema(),
-							"sid": {
-								// Because policy documents are widely used outside IAM, we don't enforce
-								// IAM validation rules requiring alphanumeric and no spaces.
-								Type:     schema.TypeString,
-								Optional: true,
-							},
 						},
+						"not_principals": principalsBlock(),
+						"principals":     principalsBlock(),
 					},
 				},
-				names.AttrVersion: {
-					Type:     schema.TypeString,
-					Optional: true,
-					Default:  "2012-10-17",
-					ValidateFunc: validation.StringInSlice([]string{
-						"2008-10-17",
-						"2012-10-17",
-					}, false),
-				},
-			}
+			},
 		},
 	}
 }
 
-func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (d *policyDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	mergedDoc := &IAMPolicyDoc{}
 
-	if v, ok := d.GetOk("source_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		// generate sid map to assure there are no duplicates in source jsons
-		sidMap := make(map[string]struct{})
-		for _, stmt := range mergedDoc.Statements {
-			if stmt.Sid != "" {
-				sidMap[stmt.Sid] = struct{}{}
-			}
-		}
+	// generate sid map to assure there are no duplicates in source documents
+	sidMap := make(map[string]struct{})
 
-		// merge sourceDocs in order specified
-		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
-			if sourceJSON == nil {
-				continue
-			}
+	// merge source documents in order specified
+	for sourceIndex, v := range data.SourcePolicyDocuments.Elements() {
+		sourceDocument := v.(types.String)
+		if sourceDocument.IsNull() || sourceDocument.ValueString() == "" {
+			continue
+		}
 
-			sourceDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: %s", sourceJSONIndex, err)
-			}
+		sourceDoc, err := policyDocumentDecode(sourceDocument.ValueString(), true)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("source_policy_documents").AtListIndex(sourceIndex),
+				"writing IAM Policy Document",
+				fmt.Sprintf("merging source document %d: %s", sourceIndex, err),
+			)
+			return
+		}
 
-			// assure all statements in sourceDoc are unique before merging
-			for stmtIndex, stmt := range sourceDoc.Statements {
-				if stmt.Sid != "" {
-					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceJSONIndex, stmt.Sid, stmtIndex)
-					}
-					sidMap[stmt.Sid] = struct{}{}
+		// assure all statements in sourceDoc are unique before merging
+		for stmtIndex, stmt := range sourceDoc.Statements {
+			if stmt.Sid != "" {
+				if _, sidExists := sidMap[stmt.Sid]; sidExists {
+					response.Diagnostics.AddAttributeError(
+						path.Root("source_policy_documents").AtListIndex(sourceIndex),
+						"writing IAM Policy Document",
+						fmt.Sprintf("merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", sourceIndex, stmt.Sid, stmtIndex),
+					)
+					return
 				}
+				sidMap[stmt.Sid] = struct{}{}
 			}
-
-			mergedDoc.Merge(sourceDoc)
 		}
+
+		mergedDoc.Merge(sourceDoc)
 	}
 
 	// process the current document
-	doc := &IAMPolicyDoc{
-		Version: d.Get(names.AttrVersion).(string),
+	if data.Version.IsNull() {
+		data.Version = types.StringValue(policyDocumentVersion20121017)
 	}
 
-	if policyID, hasPolicyID := d.GetOk("policy_id"); hasPolicyID {
-		doc.Id = policyID.(string)
+	doc := &IAMPolicyDoc{
+		Version: data.Version.ValueString(),
+		Id:      data.PolicyID.ValueString(),
 	}
 
-	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
-		var cfgStmtIntf = cfgStmts.([]interface{})
-		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
-		sidMap := make(map[string]struct{})
-
-		for i, stmtI := range cfgStmtIntf {
-			cfgStmt := stmtI.(map[string]interface{})
-			stmt := &IAMPolicyStatement{
-				Effect: cfgStmt["effect"].(string),
-			}
-
-			if sid, ok := cfgStmt["sid"]; ok {
-				if _, ok := sidMap[sid.(string)]; ok {
-					return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
-				}
-				stmt.Sid = sid.(string)
-				if len(stmt.Sid) > 0 {
-					sidMap[stmt.Sid] = struct{}{}
-				}
-			}
+	if !data.Statement.IsNull() {
+		cfgStmts, diags := data.Statement.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-			if actions := cfgStmt[names.AttrActions].(*schema.Set).List(); len(actions) > 0 {
-				stmt.Actions = policyDecodeConfigStringList(actions)
-			}
-			if actions := cfgStmt["not_actions"].(*schema.Set).List(); len(actions) > 0 {
-				stmt.NotActions = policyDecodeConfigStringList(actions)
-			}
+		stmts := make([]*IAMPolicyStatement, len(cfgStmts))
+		sidMap := make(map[string]struct{})
 
-			if resources := cfgStmt[names.AttrResources].(*schema.Set).List(); len(resources) > 0 {
-				var err error
-				stmt.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(resources), doc.Version,
-				)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading resources: %s", err)
-				}
-			}
-			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
-				var err error
-				stmt.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(
-					policyDecodeConfigStringList(notResources), doc.Version,
-				)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_resources: %s", err)
-				}
+		for i, cfgStmt := range cfgStmts {
+			if cfgStmt.Effect.IsNull() {
+				cfgStmt.Effect = types.StringValue("Allow")
 			}
 
-			if principals := cfgStmt["principals"].(*schema.Set).List(); len(principals) > 0 {
-				var err error
-				stmt.Principals, err = dataSourcePolicyDocumentMakePrincipals(principals, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading principals: %s", err)
-				}
+			stmt, err := expandPolicyDocumentStatement(ctx, cfgStmt, doc.Version)
+			if err != nil {
+				response.Diagnostics.AddAttributeError(path.Root("statement").AtListIndex(i), "writing IAM Policy Document", err.Error())
+				return
 			}
 
-			if notPrincipals := cfgStmt["not_principals"].(*schema.Set).List(); len(notPrincipals) > 0 {
-				var err error
-				stmt.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(notPrincipals, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading not_principals: %s", err)
-				}
-			}
-
-			if conditions := cfgStmt[names.AttrCondition].(*schema.Set).List(); len(conditions) > 0 {
-				var err error
-				stmt.Conditions, err = dataSourcePolicyDocumentMakeConditions(conditions, doc.Version)
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading condition: %s", err)
+			if stmt.Sid != "" {
+				if _, ok := sidMap[stmt.Sid]; ok {
+					response.Diagnostics.AddAttributeError(
+						path.Root("statement").AtListIndex(i).AtName("sid"),
+						"writing IAM Policy Document",
+						fmt.Sprintf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", stmt.Sid),
+					)
+					return
 				}
+				sidMap[stmt.Sid] = struct{}{}
 			}
 
 			stmts[i] = stmt
 		}
 
 		doc.Statements = stmts
+		data.Statement = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, cfgStmts)
 	}
 
 	// merge our current document into mergedDoc
 	mergedDoc.Merge(doc)
 
 	// merge override_policy_documents policies into mergedDoc in order specified
-	if v, ok := d.GetOk("override_policy_documents"); ok && len(v.([]interface{})) > 0 {
-		for overrideJSONIndex, overrideJSON := range v.([]interface{}) {
-			if overrideJSON == nil {
-				continue
-			}
-			overrideDoc := &IAMPolicyDoc{}
-			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
-			}
+	for overrideIndex, v := range data.OverridePolicyDocuments.Elements() {
+		overrideDocument := v.(types.String)
+		if overrideDocument.IsNull() || overrideDocument.ValueString() == "" {
+			continue
+		}
 
-			mergedDoc.Merge(overrideDoc)
+		overrideDoc, err := policyDocumentDecode(overrideDocument.ValueString(), false)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("override_policy_documents").AtListIndex(overrideIndex),
+				"writing IAM Policy Document",
+				fmt.Sprintf("merging override document %d: %s", overrideIndex, err),
+			)
+			return
 		}
+
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	if v := data.PolicyType.ValueString(); v != "" {
+		response.Diagnostics.Append(lintPolicyDocument(mergedDoc, v)...)
 	}
 
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		response.Diagnostics.AddError("writing IAM Policy Document", fmt.Sprintf("formatting JSON: %s", err))
+		return
 	}
 	jsonString := string(jsonDoc)
 
-	d.Set(names.AttrJSON, jsonString)
-
 	jsonMinDoc, err := json.Marshal(mergedDoc)
 	if err != nil {
 		// should never happen if the above code is correct
-		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+		response.Diagnostics.AddError("writing IAM Policy Document", fmt.Sprintf("formatting JSON: %s", err))
+		return
+	}
+
+	statements, err := flattenPolicyDocumentStatements(ctx, mergedDoc.Statements)
+	if err != nil {
+		response.Diagnostics.AddError("writing IAM Policy Document", fmt.Sprintf("flattening statements: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(create.StringHashcode(jsonString)))
+	data.JSON = types.StringValue(jsonString)
+	data.MinifiedJSON = types.StringValue(string(jsonMinDoc))
+	data.Statements = statements
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// policyDocumentDecode decodes a JSON policy document.
+// If allowYAML is true and the document is not valid JSON, it is decoded as YAML.
+func policyDocumentDecode(s string, allowYAML bool) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{}
+
+	err := json.Unmarshal([]byte(s), doc)
+
+	if err == nil || !allowYAML || json.Valid([]byte(s)) {
+		return doc, err
+	}
+
+	var v any
+	if yamlErr := tfyaml.DecodeFromString(s, &v); yamlErr != nil {
+		// Report the JSON error as that is by far the more common document format.
+		return nil, err
 	}
-	jsonMinString := string(jsonMinDoc)
 
-	d.Set("minified_json", jsonMinString)
+	b, jsonErr := json.Marshal(v)
+	if jsonErr != nil {
+		return nil, fmt.Errorf("converting YAML to JSON: %w", jsonErr)
+	}
+
+	doc = &IAMPolicyDoc{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		return nil, err
+	}
 
-	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	return doc, nil
+}
+
+// lintPolicyDocument returns warning diagnostics for statements that are inconsistent with the given policy type.
+// Identity-based policies must not specify a principal and resource-based policies must specify one.
+func lintPolicyDocument(doc *IAMPolicyDoc, policyType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, stmt := range doc.Statements {
+		name := strconv.Itoa(i)
+		if stmt.Sid != "" {
+			name = fmt.Sprintf("%d (%s)", i, stmt.Sid)
+		}
+		hasPrincipal := len(stmt.Principals) > 0 || len(stmt.NotPrincipals) > 0
+
+		switch policyType {
+		case policyDocumentTypeIdentity:
+			if hasPrincipal {
+				diags.AddAttributeWarning(
+					path.Root("policy_type"),
+					"Principal in identity-based policy",
+					fmt.Sprintf("Statement %s specifies a Principal or NotPrincipal element, which is not supported in identity-based policies.", name),
+				)
+			}
+		case policyDocumentTypeResource:
+			if !hasPrincipal {
+				diags.AddAttributeWarning(
+					path.Root("policy_type"),
+					"Missing Principal in resource-based policy",
+					fmt.Sprintf("Statement %s does not specify a Principal or NotPrincipal element, which is required in resource-based policies.", name),
+				)
+			}
+		}
+	}
 
 	return diags
 }
 
+func expandPolicyDocumentStatement(ctx context.Context, tfObject *policyDocumentStatementModel, version string) (*IAMPolicyStatement, error) {
+	apiObject := &IAMPolicyStatement{
+		Effect: tfObject.Effect.ValueString(),
+		Sid:    tfObject.Sid.ValueString(),
+	}
+
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, tfObject.Actions); len(v) > 0 {
+		apiObject.Actions = policyDecodeConfigStringList(v)
+	}
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, tfObject.NotActions); len(v) > 0 {
+		apiObject.NotActions = policyDecodeConfigStringList(v)
+	}
+
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, tfObject.Resources); len(v) > 0 {
+		var err error
+		apiObject.Resources, err = dataSourcePolicyDocumentReplaceVarsInList(policyDecodeConfigStringList(v), version)
+		if err != nil {
+			return nil, fmt.Errorf("reading resources: %w", err)
+		}
+	}
+	if v := fwflex.ExpandFrameworkStringValueSet(ctx, tfObject.NotResources); len(v) > 0 {
+		var err error
+		apiObject.NotResources, err = dataSourcePolicyDocumentReplaceVarsInList(policyDecodeConfigStringList(v), version)
+		if err != nil {
+			return nil, fmt.Errorf("reading not_resources: %w", err)
+		}
+	}
+
+	if !tfObject.Principals.IsNull() {
+		principals, diags := tfObject.Principals.ToSlice(ctx)
+		if diags.HasError() {
+			return nil, errors.New("reading principals")
+		}
+
+		var err error
+		apiObject.Principals, err = dataSourcePolicyDocumentMakePrincipals(ctx, principals, version)
+		if err != nil {
+			return nil, fmt.Errorf("reading principals: %w", err)
+		}
+	}
+	if !tfObject.NotPrincipals.IsNull() {
+		notPrincipals, diags := tfObject.NotPrincipals.ToSlice(ctx)
+		if diags.HasError() {
+			return nil, errors.New("reading not_principals")
+		}
+
+		var err error
+		apiObject.NotPrincipals, err = dataSourcePolicyDocumentMakePrincipals(ctx, notPrincipals, version)
+		if err != nil {
+			return nil, fmt.Errorf("reading not_principals: %w", err)
+		}
+	}
+
+	if !tfObject.Conditions.IsNull() {
+		conditions, diags := tfObject.Conditions.ToSlice(ctx)
+		if diags.HasError() {
+			return nil, errors.New("reading condition")
+		}
+
+		var err error
+		apiObject.Conditions, err = dataSourcePolicyDocumentMakeConditions(ctx, conditions, version)
+		if err != nil {
+			return nil, fmt.Errorf("reading condition: %w", err)
+		}
+	}
+
+	return apiObject, nil
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
-		if version == "2008-10-17" && strings.Contains(v, "&{") {
+		if version == policyDocumentVersion20081017 && strings.Contains(v, "&{") {
 			return nil, fmt.Errorf("found &{ sequence in (%s), which is not supported in document version 2008-10-17", v)
 		}
 		return dataSourcePolicyDocumentVarReplacer.Replace(v), nil
 	case []string:
 		out := make([]string, len(v))
 		for i, item := range v {
-			if version == "2008-10-17" && strings.Contains(item, "&{") {
+			if version == policyDocumentVersion20081017 && strings.Contains(item, "&{") {
 				return nil, fmt.Errorf("found &{ sequence in (%s), which is not supported in document version 2008-10-17", item)
 			}
 			out[i] = dataSourcePolicyDocumentVarReplacer.Replace(item)
@@ -351,19 +486,19 @@ func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (
 	}
 }
 
-func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (IAMPolicyStatementConditionSet, error) {
+func dataSourcePolicyDocumentMakeConditions(ctx context.Context, in []*policyDocumentStatementConditionModel, version string) (IAMPolicyStatementConditionSet, error) {
 	out := make([]IAMPolicyStatementCondition, len(in))
-	for i, itemI := range in {
+	for i, item := range in {
 		var err error
-		item := itemI.(map[string]interface{})
 		out[i] = IAMPolicyStatementCondition{
-			Test:     item["test"].(string),
-			Variable: item["variable"].(string),
+			Test:     item.Test.ValueString(),
+			Variable: item.Variable.ValueString(),
 		}
-		out[i].Values, err = dataSourcePolicyDocumentReplaceVarsInList(
-			aws.ToStringSlice(expandStringListKeepEmpty(item[names.AttrValues].([]interface{}))),
-			version,
-		)
+		values := fwflex.ExpandFrameworkStringValueList(ctx, item.Values)
+		if values == nil {
+			values = []string{}
+		}
+		out[i].Values, err = dataSourcePolicyDocumentReplaceVarsInList(values, version)
 		if err != nil {
 			return nil, fmt.Errorf("reading values: %w", err)
 		}
@@ -375,18 +510,16 @@ func dataSourcePolicyDocumentMakeConditions(in []interface{}, version string) (I
 	return IAMPolicyStatementConditionSet(out), nil
 }
 
-func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (IAMPolicyStatementPrincipalSet, error) {
+func dataSourcePolicyDocumentMakePrincipals(ctx context.Context, in []*policyDocumentStatementPrincipalModel, version string) (IAMPolicyStatementPrincipalSet, error) {
 	out := make([]IAMPolicyStatementPrincipal, len(in))
-	for i, itemI := range in {
+	for i, item := range in {
 		var err error
-		item := itemI.(map[string]interface{})
 		out[i] = IAMPolicyStatementPrincipal{
-			Type: item[names.AttrType].(string),
+			Type: item.Type.ValueString(),
 		}
 		out[i].Identifiers, err = dataSourcePolicyDocumentReplaceVarsInList(
-			policyDecodeConfigStringList(
-				item["identifiers"].(*schema.Set).List(),
-			), version,
+			policyDecodeConfigStringList(fwflex.ExpandFrameworkStringValueSet(ctx, item.Identifiers)),
+			version,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("reading identifiers: %w", err)
@@ -394,3 +527,147 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 	}
 	return IAMPolicyStatementPrincipalSet(out), nil
 }
+
+func flattenPolicyDocumentStatements(ctx context.Context, apiObjects []*IAMPolicyStatement) (fwtypes.ListNestedObjectValueOf[policyDocumentStatementsModel], error) {
+	tfList := make([]*policyDocumentStatementsModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfObject := &policyDocumentStatementsModel{
+			Actions:      fwflex.FlattenFrameworkStringValueListOfString(ctx, policyStringList(apiObject.Actions)),
+			Effect:       fwflex.StringValueToFramework(ctx, apiObject.Effect),
+			NotActions:   fwflex.FlattenFrameworkStringValueListOfString(ctx, policyStringList(apiObject.NotActions)),
+			NotResources: fwflex.FlattenFrameworkStringValueListOfString(ctx, policyStringList(apiObject.NotResources)),
+			Resources:    fwflex.FlattenFrameworkStringValueListOfString(ctx, policyStringList(apiObject.Resources)),
+			Sid:          fwflex.StringValueToFramework(ctx, apiObject.Sid),
+		}
+
+		var err error
+		if tfObject.Principals, err = flattenPolicyDocumentPrincipals(ctx, apiObject.Principals); err != nil {
+			return fwtypes.ListNestedObjectValueOf[policyDocumentStatementsModel]{}, err
+		}
+		if tfObject.NotPrincipals, err = flattenPolicyDocumentPrincipals(ctx, apiObject.NotPrincipals); err != nil {
+			return fwtypes.ListNestedObjectValueOf[policyDocumentStatementsModel]{}, err
+		}
+
+		conditions := make([]*policyDocumentStatementConditionModel, 0, len(apiObject.Conditions))
+		for _, v := range apiObject.Conditions {
+			values, err := policyStringListE(v.Values)
+			if err != nil {
+				return fwtypes.ListNestedObjectValueOf[policyDocumentStatementsModel]{}, err
+			}
+
+			conditions = append(conditions, &policyDocumentStatementConditionModel{
+				Test:     fwflex.StringValueToFramework(ctx, v.Test),
+				Values:   fwflex.FlattenFrameworkStringValueListOfString(ctx, values),
+				Variable: fwflex.StringValueToFramework(ctx, v.Variable),
+			})
+		}
+		tfObject.Conditions = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, conditions)
+
+		tfList = append(tfList, tfObject)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, tfList), nil
+}
+
+func flattenPolicyDocumentPrincipals(ctx context.Context, apiObjects IAMPolicyStatementPrincipalSet) (fwtypes.ListNestedObjectValueOf[policyDocumentPrincipalModel], error) {
+	tfList := make([]*policyDocumentPrincipalModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		identifiers, err := policyStringListE(apiObject.Identifiers)
+		if err != nil {
+			return fwtypes.ListNestedObjectValueOf[policyDocumentPrincipalModel]{}, err
+		}
+
+		tfList = append(tfList, &policyDocumentPrincipalModel{
+			Identifiers: fwflex.FlattenFrameworkStringValueListOfString(ctx, identifiers),
+			Type:        fwflex.StringValueToFramework(ctx, apiObject.Type),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, tfList), nil
+}
+
+// policyStringList returns the string or list of strings policy element value as a list of strings.
+func policyStringList(v interface{}) []string {
+	l, _ := policyStringListE(v)
+	return l
+}
+
+func policyStringListE(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		l := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported policy element value type: %T", v)
+			}
+			l = append(l, s)
+		}
+		return l, nil
+	default:
+		return nil, fmt.Errorf("unsupported policy element value type: %T", v)
+	}
+}
+
+type policyDocumentDataSourceModel struct {
+	ID                      types.String                                                   `tfsdk:"id"`
+	JSON                    types.String                                                   `tfsdk:"json"`
+	MinifiedJSON            types.String                                                   `tfsdk:"minified_json"`
+	OverrideJSON            types.String                                                   `tfsdk:"override_json"`
+	OverridePolicyDocuments fwtypes.ListValueOf[types.String]                              `tfsdk:"override_policy_documents"`
+	PolicyID                types.String                                                   `tfsdk:"policy_id"`
+	PolicyType              types.String                                                   `tfsdk:"policy_type"`
+	SourceJSON              types.String                                                   `tfsdk:"source_json"`
+	SourcePolicyDocuments   fwtypes.ListValueOf[types.String]                              `tfsdk:"source_policy_documents"`
+	Statement               fwtypes.ListNestedObjectValueOf[policyDocumentStatementModel]  `tfsdk:"statement"`
+	Statements              fwtypes.ListNestedObjectValueOf[policyDocumentStatementsModel] `tfsdk:"statements"`
+	Version                 types.String                                                   `tfsdk:"version"`
+}
+
+type policyDocumentStatementModel struct {
+	Actions       fwtypes.SetValueOf[types.String]                                      `tfsdk:"actions"`
+	Conditions    fwtypes.SetNestedObjectValueOf[policyDocumentStatementConditionModel] `tfsdk:"condition"`
+	Effect        types.String                                                          `tfsdk:"effect"`
+	NotActions    fwtypes.SetValueOf[types.String]                                      `tfsdk:"not_actions"`
+	NotPrincipals fwtypes.SetNestedObjectValueOf[policyDocumentStatementPrincipalModel] `tfsdk:"not_principals"`
+	NotResources  fwtypes.SetValueOf[types.String]                                      `tfsdk:"not_resources"`
+	Principals    fwtypes.SetNestedObjectValueOf[policyDocumentStatementPrincipalModel] `tfsdk:"principals"`
+	Resources     fwtypes.SetValueOf[types.String]                                      `tfsdk:"resources"`
+	Sid           types.String                                                          `tfsdk:"sid"`
+}
+
+type policyDocumentStatementConditionModel struct {
+	Test     types.String                      `tfsdk:"test"`
+	Values   fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+	Variable types.String                      `tfsdk:"variable"`
+}
+
+type policyDocumentStatementPrincipalModel struct {
+	Identifiers fwtypes.SetValueOf[types.String] `tfsdk:"identifiers"`
+	Type        types.String                     `tfsdk:"type"`
+}
+
+type policyDocumentStatementsModel struct {
+	Actions       fwtypes.ListValueOf[types.String]                                      `tfsdk:"actions"`
+	Conditions    fwtypes.ListNestedObjectValueOf[policyDocumentStatementConditionModel] `tfsdk:"condition"`
+	Effect        types.String                                                           `tfsdk:"effect"`
+	NotActions    fwtypes.ListValueOf[types.String]                                      `tfsdk:"not_actions"`
+	NotPrincipals fwtypes.ListNestedObjectValueOf[policyDocumentPrincipalModel]          `tfsdk:"not_principals"`
+	NotResources  fwtypes.ListValueOf[types.String]                                      `tfsdk:"not_resources"`
+	Principals    fwtypes.ListNestedObjectValueOf[policyDocumentPrincipalModel]          `tfsdk:"principals"`
+	Resources     fwtypes.ListValueOf[types.String]                                      `tfsdk:"resources"`
+	Sid           types.String                                                           `tfsdk:"sid"`
+}
+
+type policyDocumentPrincipalModel struct {
+	Identifiers fwtypes.ListValueOf[types.String] `tfsdk:"identifiers"`
+	Type        types.String                      `tfsdk:"type"`
+}
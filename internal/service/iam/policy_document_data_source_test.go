@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -321,7 +322,7 @@ func TestAccIAMPolicyDocumentDataSource_sourcePolicyValidJSON(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccPolicyDocumentDataSourceConfig_invalidJSON,
-				ExpectError: regexache.MustCompile(`"source_policy_documents.0" contains an invalid JSON: unexpected end of JSON input`),
+				ExpectError: regexache.MustCompile(`merging source document 0: unexpected end of JSON input`),
 			},
 			{
 				Config: testAccPolicyDocumentDataSourceConfig_emptyString,
@@ -344,7 +345,7 @@ func TestAccIAMPolicyDocumentDataSource_overridePolicyDocumentValidJSON(t *testi
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccPolicyDocumentDataSourceConfig_overridePolicyDocument_invalidJSON,
-				ExpectError: regexache.MustCompile(`"override_policy_documents.0" contains an invalid JSON: unexpected end of JSON input`),
+				ExpectError: regexache.MustCompile(`merging override document 0: unexpected end of JSON input`),
 			},
 			{
 				Config: testAccPolicyDocumentDataSourceConfig_overridePolicyDocument_emptyString,
@@ -361,6 +362,154 @@ func TestAccIAMPolicyDocumentDataSource_overridePolicyDocumentValidJSON(t *testi
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_sourcePolicyDocumentYAML(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_sourcePolicyDocumentYAML,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccPolicyDocumentSourcePolicyDocumentYAMLExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_statements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_statements,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "statement.0.effect", "Allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.sid", "Source"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.effect", "Deny"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.0", "s3:DeleteBucket"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.sid", "Statement"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.effect", "Allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.actions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.principals.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.principals.0.type", "Service"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.principals.0.identifiers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.principals.0.identifiers.0", "ec2.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.condition.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.condition.0.test", "StringEquals"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.condition.0.values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_policyType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_policyType("invalid"),
+				ExpectError: regexache.MustCompile(`Attribute policy_type value must be one of`),
+			},
+			{
+				// Linting findings are reported as warnings.
+				Config: testAccPolicyDocumentDataSourceConfig_policyType("identity"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.sid", "WithPrincipal"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.sid", "WithoutPrincipal"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrJSON),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_policyType("resource"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.sid", "WithPrincipal"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.sid", "WithoutPrincipal"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestLintPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	doc := &tfiam.IAMPolicyDoc{
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Sid:    "WithPrincipal",
+				Effect: "Allow",
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{
+					{Type: "AWS", Identifiers: "*"},
+				},
+			},
+			{
+				Sid:    "WithoutPrincipal",
+				Effect: "Allow",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		policyType      string
+		expectedSummary string
+	}{
+		"identity": {
+			policyType:      "identity",
+			expectedSummary: "Principal in identity-based policy",
+		},
+		"resource": {
+			policyType:      "resource",
+			expectedSummary: "Missing Principal in resource-based policy",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfiam.LintPolicyDocument(doc, testCase.policyType)
+
+			if got, want := diags.WarningsCount(), 1; got != want {
+				t.Fatalf("warnings = %d, want %d: %v", got, want, diags)
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if got, want := diags[0].Summary(), testCase.expectedSummary; got != want {
+				t.Errorf("summary = %q, want %q", got, want)
+			}
+		})
+	}
+
+	if diags := tfiam.LintPolicyDocument(doc, ""); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics without policy type: %v", diags)
+	}
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_stringAndSlice(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1677,3 +1826,112 @@ data "aws_iam_policy_document" "partial" {
   }
 }
 `
+
+var testAccPolicyDocumentDataSourceConfig_sourcePolicyDocumentYAML = `
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [
+    <<EOT
+Version: "2012-10-17"
+Statement:
+  - Sid: YAMLSource
+    Effect: Allow
+    Action:
+      - s3:GetObject
+      - s3:ListBucket
+    Resource: "*"
+    Condition:
+      Bool:
+        aws:SecureTransport: true
+EOT
+  ]
+
+  statement {
+    sid       = "Local"
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccPolicyDocumentSourcePolicyDocumentYAMLExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "YAMLSource",
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:ListBucket"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "Bool": {
+          "aws:SecureTransport": "true"
+        }
+      }
+    },
+    {
+      "Sid": "Local",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccPolicyDocumentDataSourceConfig_statements = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "Source"
+    effect    = "Deny"
+    actions   = ["s3:DeleteBucket"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [data.aws_iam_policy_document.source.json]
+
+  statement {
+    sid       = "Statement"
+    actions   = ["s3:GetObject", "s3:ListBucket"]
+    resources = ["*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["ec2.amazonaws.com"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:SourceAccount"
+      values   = ["111111111111", "222222222222"]
+    }
+  }
+}
+`
+
+func testAccPolicyDocumentDataSourceConfig_policyType(policyType string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  policy_type = %[1]q
+
+  statement {
+    sid       = "WithPrincipal"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["*"]
+    }
+  }
+
+  statement {
+    sid       = "WithoutPrincipal"
+    actions   = ["s3:ListBucket"]
+    resources = ["*"]
+  }
+}
+`, policyType)
+}
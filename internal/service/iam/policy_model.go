@@ -235,14 +235,11 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-func policyDecodeConfigStringList(lI []interface{}) interface{} {
-	if len(lI) == 1 {
-		return lI[0].(string)
-	}
-	ret := make([]string, len(lI))
-	for i, vI := range lI {
-		ret[i] = vI.(string)
+func policyDecodeConfigStringList(l []string) interface{} {
+	if len(l) == 1 {
+		return l[0]
 	}
+	ret := slices.Clone(l)
 	slices.Sort(ret)
 	slices.Reverse(ret)
 	return ret
@@ -14,10 +14,13 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -183,10 +187,42 @@ func resourceRole() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			tfaccessanalyzer.PolicyValidationCustomizeDiff("assume_role_policy", accessanalyzertypes.PolicyTypeResourcePolicy, accessanalyzertypes.ValidatePolicyResourceTypeRoleTrust),
+			resourceRoleInlinePolicyValidationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// resourceRoleInlinePolicyValidationCustomizeDiff validates each inline_policy's policy document using IAM Access Analyzer
+// when the provider's validate_policies_with_access_analyzer setting is enabled.
+func resourceRoleInlinePolicyValidationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("inline_policy") || !d.HasChange("inline_policy") {
+		return nil
+	}
+
+	var errs []error
+
+	for _, tfMapRaw := range d.Get("inline_policy").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := fmt.Sprintf("inline_policy (%s)", tfMap[names.AttrName].(string))
+		if err := tfaccessanalyzer.ValidatePolicyDocument(ctx, meta.(*conns.AWSClient), key, tfMap[names.AttrPolicy].(string), accessanalyzertypes.PolicyTypeIdentityPolicy, ""); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return cty.GetAttrPath("inline_policy").NewError(err)
+	}
+
+	return nil
+}
+
 func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
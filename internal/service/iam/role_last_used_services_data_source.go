@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_role_last_used_services", name="Role Last Used Services")
+func dataSourceRoleLastUsedServices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRoleLastUsedServicesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unused_service_namespaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"used_service_namespaces": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRoleLastUsedServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleARN := d.Get(names.AttrRoleARN).(string)
+	input := &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(roleARN),
+		Granularity: awstypes.AccessAdvisorUsageGranularityTypeServiceLevel,
+	}
+
+	output, err := conn.GenerateServiceLastAccessedDetails(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM Role (%s) service last accessed details: %s", roleARN, err)
+	}
+
+	jobID := aws.ToString(output.JobId)
+
+	if _, err := waitServiceLastAccessedDetailsJobCompleted(ctx, conn, jobID, d.Timeout(schema.TimeoutRead)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role (%s) service last accessed details job (%s): %s", roleARN, jobID, err)
+	}
+
+	services, err := findServicesLastAccessedByJobID(ctx, conn, jobID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) service last accessed details: %s", roleARN, err)
+	}
+
+	var tfList []interface{}
+	var used, unused []string
+
+	for _, v := range services {
+		tfMap := map[string]interface{}{
+			"last_authenticated":        "",
+			"last_authenticated_entity": aws.ToString(v.LastAuthenticatedEntity),
+			"last_authenticated_region": aws.ToString(v.LastAuthenticatedRegion),
+			"service_name":              aws.ToString(v.ServiceName),
+			"service_namespace":         aws.ToString(v.ServiceNamespace),
+		}
+
+		if v.LastAuthenticated != nil {
+			tfMap["last_authenticated"] = aws.ToTime(v.LastAuthenticated).Format(time.RFC3339)
+			used = append(used, aws.ToString(v.ServiceNamespace))
+		} else {
+			unused = append(unused, aws.ToString(v.ServiceNamespace))
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(roleARN)
+	if err := d.Set("services", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services: %s", err)
+	}
+	d.Set("unused_service_namespaces", unused)
+	d.Set("used_service_namespaces", used)
+
+	return diags
+}
+
+func findServiceLastAccessedDetailsByID(ctx context.Context, conn *iam.Client, id string) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLastAccessedDetails(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findServicesLastAccessedByJobID(ctx context.Context, conn *iam.Client, id string) ([]awstypes.ServiceLastAccessed, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(id),
+	}
+	var output []awstypes.ServiceLastAccessed
+
+	for {
+		page, err := conn.GetServiceLastAccessedDetails(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServicesLastAccessed...)
+
+		if !page.IsTruncated {
+			break
+		}
+
+		input.Marker = page.Marker
+	}
+
+	return output, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRoleLastUsedServicesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_role_last_used_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleLastUsedServicesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "services.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.last_authenticated", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "services.0.service_namespace", "ec2"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_service_namespaces.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "unused_service_namespaces.*", "ec2"),
+					resource.TestCheckResourceAttr(dataSourceName, "used_service_namespaces.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleLastUsedServicesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRoleConfig_basic(rName), `
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:DescribeAccountAttributes"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+data "aws_iam_role_last_used_services" "test" {
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
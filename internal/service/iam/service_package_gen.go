@@ -15,7 +15,13 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newPolicyDocumentDataSource,
+			TypeName: "aws_iam_policy_document",
+			Name:     "Policy Document",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -91,17 +97,17 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "OIDC Provider",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourcePolicies,
+			TypeName: "aws_iam_policies",
+			Name:     "Policies",
+		},
 		{
 			Factory:  dataSourcePolicy,
 			TypeName: "aws_iam_policy",
 			Name:     "Policy",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
-		{
-			Factory:  dataSourcePolicyDocument,
-			TypeName: "aws_iam_policy_document",
-			Name:     "Policy Document",
-		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -113,6 +119,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceRoleLastUsedServices,
+			TypeName: "aws_iam_role_last_used_services",
+			Name:     "Role Last Used Services",
+		},
 		{
 			Factory:  dataSourceRoles,
 			TypeName: "aws_iam_roles",
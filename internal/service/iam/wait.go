@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -72,3 +74,41 @@ func statusRoleCreate(ctx context.Context, conn *iam.Client, id string) retry.St
 		return role, RoleStatusARNIsUniqueID, nil
 	}
 }
+
+func waitServiceLastAccessedDetailsJobCompleted(ctx context.Context, conn *iam.Client, id string, timeout time.Duration) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusTypeInProgress),
+		Target:  enum.Slice(awstypes.JobStatusTypeCompleted),
+		Refresh: statusServiceLastAccessedDetailsJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   2 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iam.GetServiceLastAccessedDetailsOutput); ok {
+		if v := output.Error; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusServiceLastAccessedDetailsJob(ctx context.Context, conn *iam.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findServiceLastAccessedDetailsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
@@ -10,6 +10,7 @@ var (
 	ResourceCustomKeyStore     = resourceCustomKeyStore
 	ResourceExternalKey        = resourceExternalKey
 	ResourceGrant              = resourceGrant
+	ResourceGrantsExclusive    = newGrantsExclusiveResource
 	ResourceKey                = resourceKey
 	ResourceKeyPolicy          = resourceKeyPolicy
 	ResourceReplicaExternalKey = resourceReplicaExternalKey
@@ -19,6 +20,7 @@ var (
 	AliasNamePrefix           = aliasNamePrefix
 	FindCustomKeyStoreByID    = findCustomKeyStoreByID
 	FindGrantByTwoPartKey     = findGrantByTwoPartKey
+	FindGrantIDsByKeyID       = findGrantIDsByKeyID
 	FindKeyByID               = findKeyByID
 	FindKeyPolicyByTwoPartKey = findKeyPolicyByTwoPartKey
 	GrantParseResourceID      = grantParseResourceID
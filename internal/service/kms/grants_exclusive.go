@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGrantsExclusive = "Grants Exclusive"
+)
+
+// @FrameworkResource("aws_kms_grants_exclusive", name="Grants Exclusive")
+func newGrantsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &grantsExclusiveResource{}, nil
+}
+
+type grantsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*grantsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_kms_grants_exclusive"
+}
+
+func (r *grantsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *grantsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := data.KeyID.ValueString()
+	if err := r.syncGrants(ctx, keyID, fwflex.ExpandFrameworkStringValueSet(ctx, data.GrantIDs)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionCreating, ResNameGrantsExclusive, keyID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *grantsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().KMSClient(ctx)
+
+	keyID := data.KeyID.ValueString()
+	grantIDs, err := findGrantIDsByKeyID(ctx, conn, keyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, ResNameGrantsExclusive, keyID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.GrantIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, grantIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *grantsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new grantsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.GrantIDs.Equal(old.GrantIDs) {
+		keyID := new.KeyID.ValueString()
+		if err := r.syncGrants(ctx, keyID, fwflex.ExpandFrameworkStringValueSet(ctx, new.GrantIDs)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionUpdating, ResNameGrantsExclusive, keyID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *grantsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrKeyID), request, response)
+}
+
+// syncGrants handles keeping the configured grants in sync with the remote
+// resource.
+//
+// Grants on the key but not configured on this resource will be revoked.
+// Grants configured on this resource must already exist on the key, as this
+// resource cannot create them.
+func (r *grantsExclusiveResource) syncGrants(ctx context.Context, keyID string, want []string) error {
+	conn := r.Meta().KMSClient(ctx)
+
+	have, err := findGrantIDsByKeyID(ctx, conn, keyID)
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("grant(s) (%s) not found on KMS Key (%s)", strings.Join(missing, ", "), keyID)
+	}
+
+	for _, grantID := range remove {
+		input := &kms.RevokeGrantInput{
+			GrantId: aws.String(grantID),
+			KeyId:   aws.String(keyID),
+		}
+
+		_, err := conn.RevokeGrant(ctx, input)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("revoking KMS Grant (%s): %w", grantCreateResourceID(keyID, grantID), err)
+		}
+	}
+
+	for _, grantID := range remove {
+		_, err := tfresource.RetryUntilNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+			return findGrantByTwoPartKey(ctx, conn, keyID, grantID)
+		})
+
+		if err != nil {
+			return fmt.Errorf("waiting for KMS Grant (%s) revoke: %w", grantCreateResourceID(keyID, grantID), err)
+		}
+	}
+
+	return nil
+}
+
+func findGrantIDsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]string, error) {
+	input := &kms.ListGrantsInput{
+		KeyId: aws.String(keyID),
+		Limit: aws.Int32(100),
+	}
+
+	grants, err := findGrants(ctx, conn, input, tfslices.PredicateTrue[*awstypes.GrantListEntry]())
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(grants, func(v awstypes.GrantListEntry) string {
+		return aws.ToString(v.GrantId)
+	}), nil
+}
+
+type grantsExclusiveResourceModel struct {
+	GrantIDs types.Set    `tfsdk:"grant_ids"`
+	KeyID    types.String `tfsdk:"key_id"`
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSGrantsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+	keyResourceName := "aws_kms_key.test"
+	grantResourceName := "aws_kms_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant_ids.*", grantResourceName, "grant_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrKeyID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrKeyID,
+			},
+		},
+	})
+}
+
+// A grant created out of band should be revoked.
+func TestAccKMSGrantsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyExists(ctx, keyResourceName, &key),
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					testAccCheckGrantsExclusiveCreateGrant(ctx, &key, "aws_iam_role.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSGrantsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "0"),
+				),
+				// The empty `grant_ids` argument will revoke the grant defined in
+				// this configuration, so a diff is expected.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGrantsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		keyID := rs.Primary.Attributes[names.AttrKeyID]
+		if keyID == "" {
+			return fmt.Errorf("No KMS Key ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		output, err := tfkms.FindGrantIDsByKeyID(ctx, conn, keyID)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["grant_ids.#"], strconv.Itoa(len(output)); got != want {
+			return fmt.Errorf("unexpected grant_ids count: got %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGrantsExclusiveCreateGrant(ctx context.Context, key *awstypes.KeyMetadata, roleResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", roleResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := conn.CreateGrant(ctx, &kms.CreateGrantInput{
+			GranteePrincipal: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			KeyId:            key.KeyId,
+			Operations:       []awstypes.GrantOperation{awstypes.GrantOperationDecrypt},
+		})
+
+		return err
+	}
+}
+
+func testAccGrantsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_basic(rName, `"Encrypt", "Decrypt"`), `
+resource "aws_kms_grants_exclusive" "test" {
+  key_id    = aws_kms_key.test.key_id
+  grant_ids = [aws_kms_grant.test.grant_id]
+}
+`)
+}
+
+func testAccGrantsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_basic(rName, `"Encrypt", "Decrypt"`), `
+resource "aws_kms_grants_exclusive" "test" {
+  # Wait until the grant is created, then provision the exclusive lock
+  # which will revoke it. This creates a diff on the next plan
+  # (to re-create aws_kms_grant.test) which the test can check for.
+  depends_on = [aws_kms_grant.test]
+
+  key_id    = aws_kms_key.test.key_id
+  grant_ids = []
+}
+`)
+}
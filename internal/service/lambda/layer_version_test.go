@@ -6,6 +6,7 @@ package lambda_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
@@ -150,6 +151,44 @@ func TestAccLambdaLayerVersion_sourceCodeHash(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+
+	testAccCopySourceDirFile(t, "test-fixtures/lambda_func.js", filepath.Join(dir, "nodejs", "lambda.js"))
+	testAccCopySourceDirFile(t, "test-fixtures/lambda_invocation.js", filepath.Join(dir, "nodejs", "lambda_invocation.js"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(dir, "nodejs", "lambda.js"))
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -453,6 +492,16 @@ resource "aws_lambda_layer_version" "test" {
 `, filename, rName)
 }
 
+func testAccLayerVersionConfig_sourceDir(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  source_dir          = %[1]q
+  source_dir_includes = ["nodejs/lambda.js"]
+  layer_name          = %[2]q
+}
+`, dir, rName)
+}
+
 func testAccLayerVersionConfig_compatibleRuntimes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly rather than through S3.
+	zipFileMaxDirectUploadSize = 50 * 1024 * 1024
+)
+
+var (
+	// Fixed modification time for all zip entries, the earliest time representable in a zip file.
+	sourceDirZipModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceDirPackage is a deployment package built from a local directory.
+type sourceDirPackage struct {
+	hash    string
+	zipFile []byte
+}
+
+// buildSourceDirPackage builds a reproducible zip file from the files in the specified directory.
+// Entries are added in lexical order with a fixed modification time and with permissions
+// normalized to 0644, or 0755 if the file has any execute bit set.
+func buildSourceDirPackage(dir string, includes, excludes []string) (*sourceDirPackage, error) {
+	files, err := tfio.FilesInDir(dir, includes, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files in %s", dir)
+	}
+
+	dir, err = homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range files {
+		if err := addSourceDirZipEntry(w, filepath.Join(dir, filepath.FromSlash(name)), name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	zipFile := buf.Bytes()
+	hash := sha256.Sum256(zipFile)
+
+	return &sourceDirPackage{
+		// Same format as the CodeSha256 returned by the Lambda API.
+		hash:    base64.StdEncoding.EncodeToString(hash[:]),
+		zipFile: zipFile,
+	}, nil
+}
+
+func addSourceDirZipEntry(w *zip.Writer, filename, name string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	var mode os.FileMode = 0o644
+	if fi.Mode()&0o111 != 0 {
+		mode = 0o755
+	}
+
+	header := &zip.FileHeader{
+		Method:   zip.Deflate,
+		Modified: sourceDirZipModified,
+		Name:     name,
+	}
+	header.SetMode(mode)
+
+	entry, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(entry, f); err != nil {
+		return fmt.Errorf("adding %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// expandSourceDirPackage builds the deployment package for the configured source_dir.
+// Unless source_code_hash is set in configuration, the package's hash must match the source_code_hash computed when planning.
+func expandSourceDirPackage(d *schema.ResourceData) (*sourceDirPackage, error) {
+	dir := d.Get("source_dir").(string)
+	pkg, err := buildSourceDirPackage(dir, flex.ExpandStringValueList(d.Get("source_dir_includes").([]interface{})), flex.ExpandStringValueList(d.Get("source_dir_excludes").([]interface{})))
+
+	if err != nil {
+		return nil, fmt.Errorf("building deployment package from %s: %w", dir, err)
+	}
+
+	if !d.GetRawConfig().GetAttr("source_code_hash").IsNull() {
+		return pkg, nil
+	}
+
+	if v := d.Get("source_code_hash").(string); v != "" && v != pkg.hash {
+		return nil, fmt.Errorf("contents of %s changed after planning, expected source_code_hash %s, got %s", dir, v, pkg.hash)
+	}
+
+	return pkg, nil
+}
+
+// uploadSourceDirPackage uploads a deployment package that's too large to upload directly to the configured staging bucket.
+// The returned function deletes the uploaded object once the package has been deployed.
+func uploadSourceDirPackage(ctx context.Context, d *schema.ResourceData, meta interface{}, pkg *sourceDirPackage, keyPrefix string) (string, string, func(), error) {
+	bucket := d.Get("source_dir_s3_bucket").(string)
+
+	if bucket == "" {
+		return "", "", nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes), set source_dir_s3_bucket to upload it through S3", len(pkg.zipFile), zipFileMaxDirectUploadSize)
+	}
+
+	hash := sha256.Sum256(pkg.zipFile)
+	key := fmt.Sprintf("%s/%s.zip", keyPrefix, hex.EncodeToString(hash[:]))
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(pkg.zipFile),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", "", nil, fmt.Errorf("uploading deployment package to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	cleanup := func() {
+		_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Deleting Lambda deployment package (s3://%s/%s): %s", bucket, key, err)
+		}
+	}
+
+	return bucket, key, cleanup, nil
+}
+
+// sourceDirCustomizeDiff builds the deployment package for source_dir when planning so that
+// changes to the directory's contents show as a change to source_code_hash.
+func sourceDirCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("source_dir"); !ok {
+		return nil
+	}
+
+	// A source_code_hash set in configuration takes precedence over the hash of the built package.
+	if v := d.GetRawConfig().GetAttr("source_code_hash"); !v.IsNull() {
+		return nil
+	}
+
+	for _, key := range []string{"source_dir", "source_dir_excludes", "source_dir_includes"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	dir := d.Get("source_dir").(string)
+	pkg, err := buildSourceDirPackage(dir, flex.ExpandStringValueList(d.Get("source_dir_includes").([]interface{})), flex.ExpandStringValueList(d.Get("source_dir_excludes").([]interface{})))
+
+	if err != nil {
+		return fmt.Errorf("building deployment package from %s: %w", dir, err)
+	}
+
+	if d.Get("source_code_hash").(string) != pkg.hash {
+		return d.SetNew("source_code_hash", pkg.hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queryCompletedTimeout = 5 * time.Minute
+)
+
+// @FrameworkDataSource("aws_cloudwatch_log_query", name="Query")
+func newQueryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queryDataSource{}, nil
+}
+
+type queryDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*queryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_query"
+}
+
+func (d *queryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bytes_scanned": schema.Float64Attribute{
+				Computed: true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"limit": schema.Int64Attribute{
+				Optional:   true,
+				Validators: queryLimitValidators(),
+			},
+			"log_group_identifiers": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Required:   true,
+				Validators: queryLogGroupIdentifiersValidators(),
+			},
+			"lookback_period": schema.StringAttribute{
+				CustomType: timetypes.GoDurationType{},
+				Optional:   true,
+				Validators: queryLookbackPeriodValidators(),
+			},
+			"query_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required:   true,
+				Validators: queryStringValidators(),
+			},
+			"records_matched": schema.Float64Attribute{
+				Computed: true,
+			},
+			"records_scanned": schema.Float64Attribute{
+				Computed: true,
+			},
+			"results": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+// The aws_cloudwatch_log_query data source and ephemeral resource validate their arguments the same way.
+
+func queryLimitValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.Between(1, 10000),
+	}
+}
+
+func queryLogGroupIdentifiersValidators() []validator.List {
+	return []validator.List{
+		listvalidator.SizeBetween(1, 50),
+	}
+}
+
+func queryLookbackPeriodValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.ExactlyOneOf(path.MatchRoot(names.AttrStartTime)),
+	}
+}
+
+func queryStringValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 10000),
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queryModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	response.Diagnostics.Append(runQuery(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// runQuery runs a Logs Insights query to completion and sets the results in the specified model.
+// It is shared by the aws_cloudwatch_log_query data source and ephemeral resource.
+func runQuery(ctx context.Context, conn *cloudwatchlogs.Client, data *queryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	endTime := time.Now()
+	if !data.EndTime.IsNull() {
+		v, d := data.EndTime.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		endTime = v
+	}
+
+	var startTime time.Time
+	if !data.StartTime.IsNull() {
+		v, d := data.StartTime.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		startTime = v
+	} else {
+		v, d := data.LookbackPeriod.ValueGoDuration()
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		startTime = endTime.Add(-v)
+	}
+
+	if !startTime.Before(endTime) {
+		diags.AddError("invalid time range", fmt.Sprintf("start time (%s) must be before end time (%s)", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)))
+
+		return diags
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:             aws.Int64(endTime.Unix()),
+		LogGroupIdentifiers: fwflex.ExpandFrameworkStringValueList(ctx, data.LogGroupIdentifiers),
+		QueryString:         fwflex.StringFromFramework(ctx, data.QueryString),
+		StartTime:           aws.Int64(startTime.Unix()),
+	}
+
+	if !data.Limit.IsNull() {
+		input.Limit = aws.Int32(int32(data.Limit.ValueInt64()))
+	}
+
+	output, err := conn.StartQuery(ctx, input)
+
+	if err != nil {
+		diags.AddError("starting CloudWatch Logs Insights query", err.Error())
+
+		return diags
+	}
+
+	queryID := aws.ToString(output.QueryId)
+	results, err := waitQueryCompleted(ctx, conn, queryID, queryCompletedTimeout)
+
+	if err != nil {
+		// Don't leave the query running in the background, even if the request has been cancelled.
+		_, _ = conn.StopQuery(context.WithoutCancel(ctx), &cloudwatchlogs.StopQueryInput{
+			QueryId: aws.String(queryID),
+		})
+
+		diags.AddError(fmt.Sprintf("waiting for CloudWatch Logs Insights query (%s) complete", queryID), err.Error())
+
+		return diags
+	}
+
+	if data.EndTime.IsNull() {
+		data.EndTime = timetypes.NewRFC3339TimeValue(endTime.Truncate(time.Second))
+	}
+	data.QueryID = types.StringValue(queryID)
+	if data.StartTime.IsNull() {
+		data.StartTime = timetypes.NewRFC3339TimeValue(startTime.Truncate(time.Second))
+	}
+
+	if v := results.Statistics; v != nil {
+		data.BytesScanned = types.Float64Value(v.BytesScanned)
+		data.RecordsMatched = types.Float64Value(v.RecordsMatched)
+		data.RecordsScanned = types.Float64Value(v.RecordsScanned)
+	} else {
+		data.BytesScanned = types.Float64Value(0)
+		data.RecordsMatched = types.Float64Value(0)
+		data.RecordsScanned = types.Float64Value(0)
+	}
+
+	rows, d := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, flattenQueryResults(results.Results))
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Results = rows
+
+	return diags
+}
+
+func findQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueryCompleted(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryStatusScheduled, awstypes.QueryStatusRunning),
+		Target:     enum.Slice(awstypes.QueryStatusComplete),
+		Refresh:    statusQuery(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenQueryResults returns each result row as a map of field name to value.
+// The internal @ptr field, which only identifies the log event, is omitted.
+func flattenQueryResults(apiObjects [][]awstypes.ResultField) []map[string]string {
+	tfList := make([]map[string]string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := make(map[string]string, len(apiObject))
+
+		for _, v := range apiObject {
+			field := aws.ToString(v.Field)
+
+			if strings.HasPrefix(field, "@ptr") {
+				continue
+			}
+
+			tfMap[field] = aws.ToString(v.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+type queryModel struct {
+	BytesScanned        types.Float64        `tfsdk:"bytes_scanned"`
+	EndTime             timetypes.RFC3339    `tfsdk:"end_time"`
+	Limit               types.Int64          `tfsdk:"limit"`
+	LogGroupIdentifiers fwtypes.ListOfString `tfsdk:"log_group_identifiers"`
+	LookbackPeriod      timetypes.GoDuration `tfsdk:"lookback_period"`
+	QueryID             types.String         `tfsdk:"query_id"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	RecordsMatched      types.Float64        `tfsdk:"records_matched"`
+	RecordsScanned      types.Float64        `tfsdk:"records_scanned"`
+	Results             types.List           `tfsdk:"results"`
+	StartTime           timetypes.RFC3339    `tfsdk:"start_time"`
+}
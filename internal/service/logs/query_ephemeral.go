@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_cloudwatch_log_query", name="Query")
+func newEphemeralQuery(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralQuery{}, nil
+}
+
+type ephemeralQuery struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*ephemeralQuery) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_query"
+}
+
+func (e *ephemeralQuery) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bytes_scanned": schema.Float64Attribute{
+				Computed: true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"limit": schema.Int64Attribute{
+				Optional:   true,
+				Validators: queryLimitValidators(),
+			},
+			"log_group_identifiers": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Required:   true,
+				Validators: queryLogGroupIdentifiersValidators(),
+			},
+			"lookback_period": schema.StringAttribute{
+				CustomType: timetypes.GoDurationType{},
+				Optional:   true,
+				Validators: queryLookbackPeriodValidators(),
+			},
+			"query_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required:   true,
+				Validators: queryStringValidators(),
+			},
+			"records_matched": schema.Float64Attribute{
+				Computed: true,
+			},
+			"records_scanned": schema.Float64Attribute{
+				Computed: true,
+			},
+			"results": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralQuery) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data queryModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().LogsClient(ctx)
+
+	response.Diagnostics.Append(runQuery(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
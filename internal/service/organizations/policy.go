@@ -9,9 +9,11 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -73,7 +76,10 @@ func resourcePolicy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyContentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -211,6 +217,23 @@ func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourcePolicyContentCustomizeDiff validates service control and resource control policies with IAM Access Analyzer.
+// Other policy types are not supported by Access Analyzer policy validation.
+func resourcePolicyContentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var policyType accessanalyzertypes.PolicyType
+
+	switch awstypes.PolicyType(d.Get(names.AttrType).(string)) {
+	case awstypes.PolicyTypeServiceControlPolicy:
+		policyType = accessanalyzertypes.PolicyTypeServiceControlPolicy
+	case awstypes.PolicyTypeResourceControlPolicy:
+		policyType = accessanalyzertypes.PolicyTypeResourceControlPolicy
+	default:
+		return nil
+	}
+
+	return tfaccessanalyzer.PolicyValidationCustomizeDiff(names.AttrContent, policyType, "")(ctx, d, meta)
+}
+
 func findPolicyByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Policy, error) {
 	input := &organizations.DescribePolicyInput{
 		PolicyId: aws.String(id),
@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -162,3 +163,220 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds.Client
+}
+
+func newClusterHandler(conn *rds.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData) error {
+	// Deletion protection is copied to the Green environment. Change it first.
+	if d.HasChange(names.AttrDeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(d.Id()),
+			DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
+		}
+
+		if _, err := h.conn.ModifyDBCluster(ctx, input); err != nil {
+			return fmt.Errorf("setting pre-conditions: %s", err)
+		}
+
+		if _, err := waitDBClusterAvailable(ctx, h.conn, d.Id(), false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("setting pre-conditions: waiting for completion: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds.CreateBlueGreenDeploymentInput {
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	return input
+}
+
+// update creates a Blue/Green Deployment for the specified DB cluster, switches over to the Green environment
+// and deletes the deployment and the old Blue environment's DB cluster and DB instances.
+// If modifyTarget is not nil it is called to make further changes to the Green environment before switchover.
+// The DB cluster and its DB instances keep their identifiers after switchover.
+func (h *clusterHandler) update(ctx context.Context, identifier string, input *rds.CreateBlueGreenDeploymentInput, modifyTarget func(context.Context, *types.BlueGreenDeployment, time.Duration) error, timeout time.Duration) (err error) {
+	deadline := tfresource.NewDeadline(timeout)
+	orchestrator := newBlueGreenOrchestrator(h.conn)
+	defer orchestrator.CleanUp(ctx)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", identifier)
+
+	dep, err := orchestrator.CreateDeployment(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", identifier)
+
+		// Ensure that the Blue/Green Deployment, and its Green environment unless switched over, is always cleaned up.
+		// The deployment is read again as dep is nil if waiting for it failed.
+		dep, findErr := findBlueGreenDeploymentByID(ctx, h.conn, aws.ToString(deploymentIdentifier))
+
+		if tfresource.NotFound(findErr) {
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment: deployment disappeared", identifier)
+			return
+		}
+
+		if findErr != nil {
+			err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment: %s", findErr))
+			return
+		}
+
+		input := &rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: deploymentIdentifier,
+		}
+		if aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+
+		if _, deleteErr := h.conn.DeleteBlueGreenDeployment(ctx, input); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment: %s", deleteErr))
+			return
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+			if _, waitErr := waitBlueGreenDeploymentDeleted(ctx, conn, aws.ToString(deploymentIdentifier), deadline.Remaining(), optFns...); waitErr != nil {
+				err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment: waiting for completion: %s", waitErr))
+			}
+		})
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+	if err != nil {
+		return err
+	}
+
+	targetARN, err := parseDBClusterARN(aws.ToString(dep.Target))
+	if err != nil {
+		return fmt.Errorf("creating Blue/Green Deployment: waiting for Green environment: %s", err)
+	}
+
+	if _, err := waitDBClusterAvailable(ctx, h.conn, targetARN.Identifier, false, deadline.Remaining()); err != nil {
+		return fmt.Errorf("creating Blue/Green Deployment: waiting for Green environment: %s", err)
+	}
+
+	if modifyTarget != nil {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Updating Green environment", identifier)
+
+		if err := modifyTarget(ctx, dep, deadline.Remaining()); err != nil {
+			return fmt.Errorf("updating Green environment: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", identifier)
+
+	dep, err = orchestrator.Switchover(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", identifier)
+
+	sourceARN, err := parseDBClusterARN(aws.ToString(dep.Source))
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %s", err)
+	}
+
+	source, err := findDBClusterByID(ctx, h.conn, sourceARN.Identifier)
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %s", err)
+	}
+
+	if aws.ToBool(source.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(sourceARN.Identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if _, err := h.conn.ModifyDBCluster(ctx, input); err != nil {
+			return fmt.Errorf("deleting Blue/Green Deployment source: disabling deletion protection: %s", err)
+		}
+	}
+
+	// The DB instances must be deleted before the DB cluster.
+	for _, member := range source.DBClusterMembers {
+		memberIdentifier := aws.ToString(member.DBInstanceIdentifier)
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(memberIdentifier),
+		}
+
+		_, err := h.conn.DeleteDBInstance(ctx, input)
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil && !errs.IsAErrorMessageContains[*types.InvalidDBInstanceStateFault](err, "is already being deleted") {
+			return fmt.Errorf("deleting Blue/Green Deployment source: deleting RDS Cluster Instance (%s): %s", memberIdentifier, err)
+		}
+	}
+
+	for _, member := range source.DBClusterMembers {
+		memberIdentifier := aws.ToString(member.DBInstanceIdentifier)
+
+		if _, err := waitDBClusterInstanceDeleted(ctx, h.conn, memberIdentifier, deadline.Remaining()); err != nil {
+			return fmt.Errorf("deleting Blue/Green Deployment source: waiting for RDS Cluster Instance (%s) delete: %s", memberIdentifier, err)
+		}
+	}
+
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, 5*time.Minute,
+		func() (interface{}, error) {
+			return h.conn.DeleteDBCluster(ctx, &rds.DeleteDBClusterInput{
+				DBClusterIdentifier: aws.String(sourceARN.Identifier),
+				SkipFinalSnapshot:   aws.Bool(true),
+			})
+		},
+		errCodeInvalidParameterCombination, "disable deletion pro")
+
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %s", err)
+	}
+
+	orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+		if _, waitErr := waitDBClusterDeleted(ctx, conn, sourceARN.Identifier, deadline.Remaining()); waitErr != nil {
+			err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment source: waiting for completion: %s", waitErr))
+		}
+	})
+
+	return nil
+}
+
+// blueGreenDeploymentTargetMember returns the ARN of the Green environment's copy of the specified Blue environment resource.
+func blueGreenDeploymentTargetMember(dep *types.BlueGreenDeployment, sourceMember string) (string, error) {
+	for _, v := range dep.SwitchoverDetails {
+		if aws.ToString(v.SourceMember) == sourceMember {
+			if targetMember := aws.ToString(v.TargetMember); targetMember != "" {
+				return targetMember, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no Green environment member for %s", sourceMember)
+}
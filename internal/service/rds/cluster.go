@@ -5,8 +5,10 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -107,6 +109,19 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -638,6 +653,25 @@ func resourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(dbClusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
 			customdiff.ForceNewIf(names.AttrStorageType, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get(names.AttrEngine).(string), "aurora")
@@ -1482,15 +1516,45 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are made in a Blue/Green Deployment's Green environment
+	// which then replaces the existing DB cluster and DB instances. Other changes are made to the DB cluster afterwards.
+	blueGreenKeys := []string{
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		names.AttrEngineVersion,
+	}
+	var blueGreenUpdated bool
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(blueGreenKeys...) {
+		handler := newClusterHandler(conn)
+
+		if err := handler.precondition(ctx, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		if err := handler.update(ctx, d.Id(), handler.createBlueGreenInput(d), nil, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		blueGreenUpdated = true
+	}
+
+	exceptKeys := []string{
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll) {
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreenUpdated {
+		exceptKeys = append(exceptKeys, blueGreenKeys...)
+		exceptKeys = append(exceptKeys, names.AttrDeletionProtection)
+	}
+
+	if d.HasChangesExcept(exceptKeys...) {
 		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(applyImmediately),
@@ -1525,7 +1589,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreenUpdated {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1534,11 +1598,11 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); (ok || d.HasChange("db_instance_parameter_group_name")) && !blueGreenUpdated {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
-		if d.HasChange(names.AttrDeletionProtection) {
+		if d.HasChange(names.AttrDeletionProtection) && !blueGreenUpdated {
 			input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
 		}
 
@@ -1570,15 +1634,17 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange(names.AttrEngineVersion) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
-		}
+		if !blueGreenUpdated {
+			if d.HasChange(names.AttrEngineVersion) {
+				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			}
 
-		// This can happen when updates are deferred (apply_immediately = false), and
-		// multiple applies occur before the maintenance window. In this case,
-		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			// This can happen when updates are deferred (apply_immediately = false), and
+			// multiple applies occur before the maintenance window. In this case,
+			// continue sending the desired engine_version as part of the modify request.
+			if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+				input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+			}
 		}
 
 		if d.HasChange("iam_database_authentication_enabled") {
@@ -1938,6 +2004,31 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *t
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func findDBClusterByID(ctx context.Context, conn *rds.Client, id string, optFns ...func(*rds.Options)) (*types.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -2263,3 +2354,10 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *types.ServerlessV2Sc
 
 	return tfMap
 }
+
+func dbClusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
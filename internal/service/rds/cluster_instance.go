@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -71,6 +73,19 @@ func resourceClusterInstance() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -241,7 +256,20 @@ func resourceClusterInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(dbClusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -447,7 +475,87 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	// DB parameter group and DB instance class changes are made in a Blue/Green Deployment of the DB cluster.
+	// The Green environment uses the new DB parameter group for all of its DB instances and its copy of this
+	// DB instance is modified to the new DB instance class before switchover. Changes that a Blue/Green Deployment
+	// made for another of the DB cluster's instances has already applied aren't made again.
+	var blueGreenUpdated bool
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges("db_parameter_group_name", "instance_class") {
+		clusterID := d.Get(names.AttrClusterIdentifier).(string)
+
+		// Only one Blue/Green Deployment of a DB cluster can be in progress at a time.
+		mutexKey := "rds-cluster-blue-green-" + clusterID
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		instance, err := findDBInstanceByID(ctx, conn, d.Id())
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Instance (%s): %s", d.Id(), err)
+		}
+
+		cluster, err := findDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", clusterID, err)
+		}
+
+		input := &rds.CreateBlueGreenDeploymentInput{
+			BlueGreenDeploymentName: aws.String(clusterID),
+			Source:                  cluster.DBClusterArn,
+		}
+		var modifyTarget func(context.Context, *types.BlueGreenDeployment, time.Duration) error
+
+		if dbParameterGroupName := d.Get("db_parameter_group_name").(string); d.HasChange("db_parameter_group_name") && !slices.ContainsFunc(instance.DBParameterGroups, func(v types.DBParameterGroupStatus) bool {
+			return aws.ToString(v.DBParameterGroupName) == dbParameterGroupName
+		}) {
+			input.TargetDBParameterGroupName = aws.String(dbParameterGroupName)
+		}
+
+		// The DB instance class of Aurora DB instances can't be set when creating the Blue/Green Deployment.
+		if instanceClass := d.Get("instance_class").(string); d.HasChange("instance_class") && aws.ToString(instance.DBInstanceClass) != instanceClass {
+			sourceARN := aws.ToString(instance.DBInstanceArn)
+			modifyTarget = func(ctx context.Context, dep *types.BlueGreenDeployment, timeout time.Duration) error {
+				targetMember, err := blueGreenDeploymentTargetMember(dep, sourceARN)
+				if err != nil {
+					return err
+				}
+
+				targetARN, err := parseDBInstanceARN(targetMember)
+				if err != nil {
+					return err
+				}
+
+				modifyInput := &rds.ModifyDBInstanceInput{
+					ApplyImmediately:     aws.Bool(true),
+					DBInstanceClass:      aws.String(instanceClass),
+					DBInstanceIdentifier: aws.String(targetARN.Identifier),
+				}
+
+				if err := dbInstanceModify(ctx, conn, targetARN.Identifier, modifyInput, timeout); err != nil {
+					return fmt.Errorf("modifying RDS Cluster Instance (%s): %s", targetARN.Identifier, err)
+				}
+
+				return nil
+			}
+		}
+
+		if input.TargetDBParameterGroupName != nil || modifyTarget != nil {
+			if err := newClusterHandler(conn).update(ctx, clusterID, input, modifyTarget, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster Instance (%s): %s", d.Id(), err)
+			}
+		}
+
+		blueGreenUpdated = true
+	}
+
+	exceptKeys := []string{
+		"blue_green_update",
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreenUpdated {
+		exceptKeys = append(exceptKeys, "db_parameter_group_name", "instance_class")
+	}
+
+	if d.HasChangesExcept(exceptKeys...) {
 		input := &rds.ModifyDBInstanceInput{
 			ApplyImmediately:     aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			DBInstanceIdentifier: aws.String(d.Id()),
@@ -465,11 +573,11 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
 		}
 
-		if d.HasChange("db_parameter_group_name") {
+		if d.HasChange("db_parameter_group_name") && !blueGreenUpdated {
 			input.DBParameterGroupName = aws.String(d.Get("db_parameter_group_name").(string))
 		}
 
-		if d.HasChange("instance_class") {
+		if d.HasChange("instance_class") && !blueGreenUpdated {
 			input.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		}
 
@@ -5,6 +5,7 @@ package rds_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -80,6 +81,89 @@ func TestAccRDSClusterInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterInstance_BlueGreenDeployment_updateParameterGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_BlueGreenDeployment_parameterGroup(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "db_parameter_group_name", "aws_db_parameter_group.initial", names.AttrName),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_BlueGreenDeployment_parameterGroup(rName, "update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v2),
+					func(*terraform.State) error {
+						if aws.ToString(v1.DbiResourceId) == aws.ToString(v2.DbiResourceId) {
+							return errors.New("RDS Cluster Instance was not replaced by a Blue/Green Deployment")
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrPair(resourceName, "db_parameter_group_name", "aws_db_parameter_group.update", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIdentifier, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterInstance_BlueGreenDeployment_updateInstanceClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_BlueGreenDeployment_instanceClass(rName, "db.t3.medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_BlueGreenDeployment_instanceClass(rName, "db.r5.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v2),
+					func(*terraform.State) error {
+						if aws.ToString(v1.DbiResourceId) == aws.ToString(v2.DbiResourceId) {
+							return errors.New("RDS Cluster Instance was not replaced by a Blue/Green Deployment")
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.large"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIdentifier, rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSClusterInstance_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1202,6 +1286,81 @@ resource "aws_rds_cluster" "test" {
 `, engine, rName))
 }
 
+func testAccClusterInstanceConfig_BlueGreenDeployment_base(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_orderableEngineBase(tfrds.ClusterEngineAuroraPostgreSQL, false), fmt.Sprintf(`
+# Blue/Green Deployments require logical replication.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  parameter {
+    name         = "rds.logical_replication"
+    value        = "1"
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[1]q
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.default.engine
+  engine_version                  = data.aws_rds_engine_version.default.version
+  database_name                   = "mydb"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharacters"
+  skip_final_snapshot             = true
+}
+`, rName))
+}
+
+func testAccClusterInstanceConfig_BlueGreenDeployment_parameterGroup(rName, parameterGroup string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_BlueGreenDeployment_base(rName), fmt.Sprintf(`
+resource "aws_db_parameter_group" "initial" {
+  name   = "%[1]s-initial"
+  family = data.aws_rds_engine_version.default.parameter_group_family
+}
+
+resource "aws_db_parameter_group" "update" {
+  name   = "%[1]s-update"
+  family = data.aws_rds_engine_version.default.parameter_group_family
+
+  parameter {
+    name  = "log_min_duration_statement"
+    value = "1000"
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier              = %[1]q
+  cluster_identifier      = aws_rds_cluster.test.id
+  engine                  = aws_rds_cluster.test.engine
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  db_parameter_group_name = aws_db_parameter_group.%[2]s.name
+  apply_immediately       = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, parameterGroup))
+}
+
+func testAccClusterInstanceConfig_BlueGreenDeployment_instanceClass(rName, instanceClass string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_BlueGreenDeployment_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = %[2]q
+  apply_immediately  = true
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, instanceClass))
+}
+
 func testAccClusterInstanceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
 resource "aws_rds_cluster_instance" "test" {
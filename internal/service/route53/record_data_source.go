@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_record", name="Record")
+func newRecordDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recordDataSource{}, nil
+}
+
+const (
+	aliasTargetResourceTypeCloudFront = "cloudfront"
+	aliasTargetResourceTypeELB        = "elb"
+	aliasTargetResourceTypeELBV2      = "elbv2"
+)
+
+type recordDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*recordDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_record"
+}
+
+func (d *recordDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_target": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[aliasTargetModel](ctx),
+				Computed:   true,
+			},
+			"alias_target_resource": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[aliasTargetResourceModel](ctx),
+				Computed:   true,
+			},
+			"cidr_routing_config": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[cidrRoutingConfigModel](ctx),
+				Computed:   true,
+			},
+			"failover": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetFailover](),
+				Computed:   true,
+			},
+			"fqdn": schema.StringAttribute{
+				Computed: true,
+			},
+			"geolocation": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[geoLocationModel](ctx),
+				Computed:   true,
+			},
+			"geoproximity_location": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[geoProximityLocationModel](ctx),
+				Computed:   true,
+			},
+			"health_check_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"multi_value_answer": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceRecordSetRegion](),
+				Computed:   true,
+			},
+			"resolve_alias_target": schema.BoolAttribute{
+				Optional: true,
+			},
+			"resource_records": framework.DataSourceComputedListOfObjectAttribute[resourceRecordModel](ctx),
+			"set_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"traffic_policy_instance_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"ttl": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+				Required:   true,
+			},
+			names.AttrWeight: schema.Int64Attribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *recordDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recordDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	zoneID := fwflex.StringValueFromFramework(ctx, data.ZoneID)
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	recordType := string(data.Type.ValueEnum())
+	output, fqdn, err := findResourceRecordSetByFourPartKey(ctx, conn, cleanZoneID(zoneID), name, recordType, fwflex.StringValueFromFramework(ctx, data.SetIdentifier))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Record (%s, %s, %s)", zoneID, name, recordType), tfresource.SingularDataSourceFindError("Route 53 Record", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.FQDN = fwflex.StringToFramework(ctx, fqdn)
+	data.AliasTargetResource = fwtypes.NewObjectValueOfNull[aliasTargetResourceModel](ctx)
+
+	if data.ResolveAliasTarget.ValueBool() && output.AliasTarget != nil {
+		resource, err := findAliasTargetResource(ctx, d.Meta(), output.AliasTarget)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			response.Diagnostics.AddError(fmt.Sprintf("resolving Route 53 Record (%s) alias target (%s)", aws.ToString(fqdn), aws.ToString(output.AliasTarget.DNSName)), err.Error())
+
+			return
+		default:
+			data.AliasTargetResource = fwtypes.NewObjectValueOfMust(ctx, resource)
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findAliasTargetResource resolves an alias target to the underlying AWS resource.
+// Only CloudFront distributions and load balancers in the configured Region are supported.
+func findAliasTargetResource(ctx context.Context, meta *conns.AWSClient, aliasTarget *awstypes.AliasTarget) (*aliasTargetResourceModel, error) {
+	dnsName := strings.TrimSuffix(strings.ToLower(aws.ToString(aliasTarget.DNSName)), ".")
+	dnsName = strings.TrimPrefix(dnsName, "dualstack.")
+
+	switch hostedZoneID := aws.ToString(aliasTarget.HostedZoneId); {
+	case hostedZoneID == meta.CloudFrontDistributionHostedZoneID(ctx):
+		return findAliasTargetCloudFrontDistribution(ctx, meta.CloudFrontClient(ctx), dnsName, hostedZoneID)
+	case strings.Contains(dnsName, ".elb."):
+		output, err := findAliasTargetLoadBalancerV2(ctx, meta.ELBV2Client(ctx), dnsName)
+
+		if !tfresource.NotFound(err) {
+			return output, err
+		}
+
+		return findAliasTargetLoadBalancer(ctx, meta, dnsName)
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func findAliasTargetCloudFrontDistribution(ctx context.Context, conn *cloudfront.Client, dnsName, hostedZoneID string) (*aliasTargetResourceModel, error) {
+	input := &cloudfront.ListDistributionsInput{}
+
+	pages := cloudfront.NewListDistributionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page.DistributionList == nil {
+			continue
+		}
+
+		for _, v := range page.DistributionList.Items {
+			if strings.ToLower(aws.ToString(v.DomainName)) == dnsName {
+				return &aliasTargetResourceModel{
+					ARN:          fwflex.StringToFramework(ctx, v.ARN),
+					HostedZoneID: types.StringValue(hostedZoneID),
+					Type:         types.StringValue(aliasTargetResourceTypeCloudFront),
+				}, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
+
+func findAliasTargetLoadBalancerV2(ctx context.Context, conn *elasticloadbalancingv2.Client, dnsName string) (*aliasTargetResourceModel, error) {
+	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{}
+
+	pages := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LoadBalancers {
+			if strings.ToLower(aws.ToString(v.DNSName)) == dnsName {
+				return &aliasTargetResourceModel{
+					ARN:          fwflex.StringToFramework(ctx, v.LoadBalancerArn),
+					HostedZoneID: fwflex.StringToFramework(ctx, v.CanonicalHostedZoneId),
+					Type:         types.StringValue(aliasTargetResourceTypeELBV2),
+				}, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
+
+func findAliasTargetLoadBalancer(ctx context.Context, meta *conns.AWSClient, dnsName string) (*aliasTargetResourceModel, error) {
+	input := &elasticloadbalancing.DescribeLoadBalancersInput{}
+
+	pages := elasticloadbalancing.NewDescribeLoadBalancersPaginator(meta.ELBClient(ctx), input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LoadBalancerDescriptions {
+			if strings.ToLower(aws.ToString(v.DNSName)) == dnsName {
+				return &aliasTargetResourceModel{
+					ARN:          types.StringValue(meta.RegionalARN(ctx, "elasticloadbalancing", "loadbalancer/"+aws.ToString(v.LoadBalancerName))),
+					HostedZoneID: fwflex.StringToFramework(ctx, v.CanonicalHostedZoneNameID),
+					Type:         types.StringValue(aliasTargetResourceTypeELB),
+				}, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{LastRequest: input}
+}
+
+type recordDataSourceModel struct {
+	AliasTarget             fwtypes.ObjectValueOf[aliasTargetModel]                `tfsdk:"alias_target"`
+	AliasTargetResource     fwtypes.ObjectValueOf[aliasTargetResourceModel]        `tfsdk:"alias_target_resource" autoflex:"-"`
+	CIDRRoutingConfig       fwtypes.ObjectValueOf[cidrRoutingConfigModel]          `tfsdk:"cidr_routing_config"`
+	Failover                fwtypes.StringEnum[awstypes.ResourceRecordSetFailover] `tfsdk:"failover"`
+	FQDN                    types.String                                           `tfsdk:"fqdn" autoflex:"-"`
+	GeoLocation             fwtypes.ObjectValueOf[geoLocationModel]                `tfsdk:"geolocation"`
+	GeoProximityLocation    fwtypes.ObjectValueOf[geoProximityLocationModel]       `tfsdk:"geoproximity_location"`
+	HealthCheckID           types.String                                           `tfsdk:"health_check_id"`
+	MultiValueAnswer        types.Bool                                             `tfsdk:"multi_value_answer"`
+	Name                    types.String                                           `tfsdk:"name" autoflex:"-"`
+	Region                  fwtypes.StringEnum[awstypes.ResourceRecordSetRegion]   `tfsdk:"region"`
+	ResolveAliasTarget      types.Bool                                             `tfsdk:"resolve_alias_target" autoflex:"-"`
+	ResourceRecords         fwtypes.ListNestedObjectValueOf[resourceRecordModel]   `tfsdk:"resource_records"`
+	SetIdentifier           types.String                                           `tfsdk:"set_identifier" autoflex:"-"`
+	TrafficPolicyInstanceID types.String                                           `tfsdk:"traffic_policy_instance_id"`
+	TTL                     types.Int64                                            `tfsdk:"ttl"`
+	Type                    fwtypes.StringEnum[awstypes.RRType]                    `tfsdk:"type" autoflex:"-"`
+	Weight                  types.Int64                                            `tfsdk:"weight"`
+	ZoneID                  types.String                                           `tfsdk:"zone_id" autoflex:"-"`
+}
+
+type aliasTargetResourceModel struct {
+	ARN          types.String `tfsdk:"arn"`
+	HostedZoneID types.String `tfsdk:"hosted_zone_id"`
+	Type         types.String `tfsdk:"type"`
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_record.test"
+	resourceName := "aws_route53_record.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDataSourceConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "alias_target"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fqdn", resourceName, "fqdn"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, recordName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_records.0.value", "127.0.0.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ttl", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "A"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordDataSource_setIdentifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_record.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDataSourceConfig_setIdentifier(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_records.0.value", "127.0.0.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "set_identifier", "dev"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrWeight, "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordDataSource_resolveAliasTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_record.test"
+	elbResourceName := "aws_elb.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordDataSourceConfig_resolveAliasTarget(rName, zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "alias_target.evaluate_target_health", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias_target.hosted_zone_id", elbResourceName, "zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias_target_resource.arn", elbResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias_target_resource.hosted_zone_id", elbResourceName, "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "alias_target_resource.type", "elb"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordDataSourceConfig_basic(zName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s."
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[2]q
+  type    = "A"
+  ttl     = "30"
+  records = ["127.0.0.1"]
+}
+
+data "aws_route53_record" "test" {
+  zone_id = aws_route53_record.test.zone_id
+  name    = aws_route53_record.test.name
+  type    = "A"
+}
+`, zName, rName)
+}
+
+func testAccRecordDataSourceConfig_setIdentifier(zName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s."
+}
+
+resource "aws_route53_record" "live" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = %[2]q
+  type           = "A"
+  ttl            = "30"
+  records        = ["127.0.0.1"]
+  set_identifier = "live"
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+
+resource "aws_route53_record" "dev" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = %[2]q
+  type           = "A"
+  ttl            = "30"
+  records        = ["127.0.0.2"]
+  set_identifier = "dev"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+data "aws_route53_record" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = aws_route53_record.dev.name
+  type           = "A"
+  set_identifier = aws_route53_record.dev.set_identifier
+
+  depends_on = [aws_route53_record.live]
+}
+`, zName, rName)
+}
+
+func testAccRecordDataSourceConfig_resolveAliasTarget(rName, zName, recordName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[2]s."
+}
+
+resource "aws_elb" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 1)
+
+  listener {
+    instance_port     = 80
+    instance_protocol = "http"
+    lb_port           = 80
+    lb_protocol       = "http"
+  }
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[3]q
+  type    = "A"
+
+  alias {
+    zone_id                = aws_elb.test.zone_id
+    name                   = aws_elb.test.dns_name
+    evaluate_target_health = true
+  }
+}
+
+data "aws_route53_record" "test" {
+  zone_id              = aws_route53_record.test.zone_id
+  name                 = aws_route53_record.test.name
+  type                 = "A"
+  resolve_alias_target = true
+}
+`, rName, zName, recordName))
+}
@@ -18,6 +18,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newRecordDataSource,
+			TypeName: "aws_route53_record",
+			Name:     "Record",
+		},
 		{
 			Factory:  newRecordsDataSource,
 			TypeName: "aws_route53_records",
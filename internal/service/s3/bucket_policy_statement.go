@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_bucket_policy_statement", name="Bucket Policy Statement")
+func resourceBucketPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBucketPolicyStatementCreate,
+		ReadWithoutTimeout:   resourceBucketPolicyStatementRead,
+		UpdateWithoutTimeout: resourceBucketPolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceBucketPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      suppressEquivalentBucketPolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketPolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, sid := d.Get(names.AttrBucket).(string), d.Get("sid").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	id := bucketPolicyStatementCreateResourceID(bucket, sid)
+
+	statement, err := expandBucketPolicyStatement(sid, d.Get("statement").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := modifyBucketPolicyStatement(ctx, conn, bucket, sid, statement, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Bucket Policy Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Policy Statement (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceBucketPolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, sid, err := bucketPolicyStatementParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	statement, err := findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	v, err := json.Marshal(statement)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Retain the configured statement if it is equivalent to the remote one.
+	if old := d.Get("statement").(string); bucketPolicyStatementsEquivalent(sid, old, string(v)) {
+		v = []byte(old)
+	}
+
+	d.Set(names.AttrBucket, bucket)
+	d.Set("sid", sid)
+	d.Set("statement", string(v))
+
+	return diags
+}
+
+func resourceBucketPolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, sid := d.Get(names.AttrBucket).(string), d.Get("sid").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	statement, err := expandBucketPolicyStatement(sid, d.Get("statement").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := modifyBucketPolicyStatement(ctx, conn, bucket, sid, statement, false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Bucket Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBucketPolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceBucketPolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, sid, err := bucketPolicyStatementParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Bucket Policy Statement: %s", d.Id())
+	err = modifyBucketPolicyStatement(ctx, conn, bucket, sid, nil, false)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketPolicyStatementByTwoPartKey(ctx, conn, bucket, sid)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Bucket Policy Statement (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// modifyBucketPolicyStatement performs a read-modify-write of the specified bucket's policy,
+// replacing the statement with the specified Sid. A nil statement removes the statement.
+// The bucket policy is deleted once it no longer contains any statements.
+func modifyBucketPolicyStatement(ctx context.Context, conn *s3.Client, bucket, sid string, statement map[string]any, create bool) error {
+	mutexKey := "s3-bucket-policy-" + bucket
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	document := map[string]any{
+		"Version": "2012-10-17",
+	}
+
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	switch {
+	case tfresource.NotFound(err):
+		if statement == nil {
+			return nil
+		}
+	case err != nil:
+		return fmt.Errorf("reading S3 Bucket (%s) Policy: %w", bucket, err)
+	default:
+		if err := json.Unmarshal([]byte(policy), &document); err != nil {
+			return fmt.Errorf("decoding S3 Bucket (%s) Policy: %w", bucket, err)
+		}
+	}
+
+	statements, err := bucketPolicyDocumentStatements(document)
+	if err != nil {
+		return fmt.Errorf("S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	if create && slices.ContainsFunc(statements, func(v map[string]any) bool { return v["Sid"] == sid }) {
+		return fmt.Errorf("S3 Bucket (%s) Policy already contains a statement with Sid (%s)", bucket, sid)
+	}
+
+	statements = slices.DeleteFunc(statements, func(v map[string]any) bool { return v["Sid"] == sid })
+	if statement != nil {
+		statements = append(statements, statement)
+	}
+
+	if len(statements) == 0 {
+		_, err := conn.DeleteBucketPolicy(ctx, &s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) Policy: %w", bucket, err)
+		}
+
+		return nil
+	}
+
+	document["Statement"] = statements
+
+	v, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(string(v)),
+	}
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketPolicy(ctx, input)
+	}, errCodeMalformedPolicy, errCodeNoSuchBucket)
+
+	if err != nil {
+		return fmt.Errorf("putting S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func findBucketPolicyStatementByTwoPartKey(ctx context.Context, conn *s3.Client, bucket, sid string) (map[string]any, error) {
+	policy, err := findBucketPolicy(ctx, conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, err
+	}
+
+	statements, err := bucketPolicyDocumentStatements(document)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range statements {
+		if v["Sid"] == sid {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastError: fmt.Errorf("S3 Bucket (%s) Policy statement with Sid (%s) not found", bucket, sid),
+	}
+}
+
+// bucketPolicyDocumentStatements returns the statements in a decoded policy document.
+// The "Statement" element may be either a single statement or a list of statements.
+func bucketPolicyDocumentStatements(document map[string]any) ([]map[string]any, error) {
+	var statements []map[string]any
+
+	switch v := document["Statement"].(type) {
+	case nil:
+	case map[string]any:
+		statements = append(statements, v)
+	case []any:
+		for _, v := range v {
+			statement, ok := v.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("unexpected statement type: %T", v)
+			}
+			statements = append(statements, statement)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected Statement type: %T", v)
+	}
+
+	return statements, nil
+}
+
+// expandBucketPolicyStatement decodes a single policy statement, setting its Sid.
+func expandBucketPolicyStatement(sid, s string) (map[string]any, error) {
+	var statement map[string]any
+	if err := json.Unmarshal([]byte(s), &statement); err != nil {
+		return nil, fmt.Errorf("decoding statement: %w", err)
+	}
+
+	if v, ok := statement["Sid"]; ok && v != sid {
+		return nil, fmt.Errorf("statement Sid (%v) does not match sid (%s)", v, sid)
+	}
+
+	statement["Sid"] = sid
+
+	return statement, nil
+}
+
+func suppressEquivalentBucketPolicyStatementDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return bucketPolicyStatementsEquivalent(d.Get("sid").(string), old, new)
+}
+
+// bucketPolicyStatementsEquivalent wraps each statement in a policy document so that
+// IAM policy equivalence can be used to compare them.
+func bucketPolicyStatementsEquivalent(sid, s1, s2 string) bool {
+	wrap := func(s string) (string, error) {
+		statement, err := expandBucketPolicyStatement(sid, s)
+		if err != nil {
+			return "", err
+		}
+
+		v, err := json.Marshal(map[string]any{
+			"Version":   "2012-10-17",
+			"Statement": []any{statement},
+		})
+
+		return string(v), err
+	}
+
+	if strings.TrimSpace(s1) == "" || strings.TrimSpace(s2) == "" {
+		return false
+	}
+
+	p1, err := wrap(s1)
+	if err != nil {
+		return false
+	}
+	p2, err := wrap(s2)
+	if err != nil {
+		return false
+	}
+
+	return verify.PolicyStringsEquivalent(p1, p2)
+}
+
+const bucketPolicyStatementResourceIDSeparator = ","
+
+func bucketPolicyStatementCreateResourceID(bucket, sid string) string {
+	parts := []string{bucket, sid}
+	id := strings.Join(parts, bucketPolicyStatementResourceIDSeparator)
+
+	return id
+}
+
+func bucketPolicyStatementParseResourceID(id string) (string, string, error) {
+	// Bucket names cannot contain the separator but a statement's Sid can.
+	parts := strings.SplitN(id, bucketPolicyStatementResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sSID", id, bucketPolicyStatementResourceIDSeparator)
+}
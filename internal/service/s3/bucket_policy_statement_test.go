@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBucketPolicyStatementParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id             string
+		expectedBucket string
+		expectedSid    string
+		expectError    bool
+	}{
+		{
+			id:          "",
+			expectError: true,
+		},
+		{
+			id:          "bucket",
+			expectError: true,
+		},
+		{
+			id:          "bucket,",
+			expectError: true,
+		},
+		{
+			id:          ",sid",
+			expectError: true,
+		},
+		{
+			id:             "bucket,sid",
+			expectedBucket: "bucket",
+			expectedSid:    "sid",
+		},
+		{
+			id:             "bucket,sid,with,commas",
+			expectedBucket: "bucket",
+			expectedSid:    "sid,with,commas",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, sid, err := tfs3.BucketPolicyStatementParseResourceID(testCase.id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if bucket != testCase.expectedBucket {
+				t.Errorf("bucket = %q, want %q", bucket, testCase.expectedBucket)
+			}
+			if sid != testCase.expectedSid {
+				t.Errorf("sid = %q, want %q", sid, testCase.expectedSid)
+			}
+		})
+	}
+}
+
+func TestAccS3BucketPolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_policy_statement.test1"
+	resourceName2 := "aws_s3_bucket_policy_statement.test2"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName, "s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName1),
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName2),
+					resource.TestCheckResourceAttrPair(resourceName1, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName1, "sid", "ReadObjects"),
+					resource.TestCheckResourceAttrPair(resourceName2, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName2, "sid", "ListBucket"),
+				),
+			},
+			{
+				ResourceName:      resourceName1,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The statement is read back in the normalized form returned by S3.
+				ImportStateVerifyIgnore: []string{"statement"},
+			},
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName, "s3:GetObjectVersion"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName1),
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName2),
+					resource.TestCheckResourceAttrSet(resourceName1, "statement"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketPolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+			if rs.Type != "aws_s3_bucket_policy_statement" {
+				continue
+			}
+
+			if tfs3.IsDirectoryBucket(rs.Primary.Attributes[names.AttrBucket]) {
+				conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+			}
+
+			_, err := tfs3.FindBucketPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketPolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes[names.AttrBucket]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		_, err := tfs3.FindBucketPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccBucketPolicyStatementConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_policy_statement" "test1" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "ReadObjects"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = %[2]q
+    Resource = "${aws_s3_bucket.test.arn}/*"
+  })
+}
+
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  sid    = "ListBucket"
+
+  statement = jsonencode({
+    Effect = "Allow"
+    Principal = {
+      AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+    }
+    Action   = "s3:ListBucket"
+    Resource = aws_s3_bucket.test.arn
+  })
+}
+`, rName, action)
+}
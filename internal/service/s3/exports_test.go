@@ -19,6 +19,7 @@ var (
 	ResourceBucketObject                            = resourceBucketObject
 	ResourceBucketOwnershipControls                 = resourceBucketOwnershipControls
 	ResourceBucketPolicy                            = resourceBucketPolicy
+	ResourceBucketPolicyStatement                   = resourceBucketPolicyStatement
 	ResourceBucketPublicAccessBlock                 = resourceBucketPublicAccessBlock
 	ResourceBucketReplicationConfiguration          = resourceBucketReplicationConfiguration
 	ResourceBucketRequestPaymentConfiguration       = resourceBucketRequestPaymentConfiguration
@@ -27,6 +28,7 @@ var (
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
+	ResourceObjectsSync                             = resourceObjectsSync
 
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
@@ -40,6 +42,7 @@ var (
 	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
+	FindBucketPolicyStatementByTwoPartKey = findBucketPolicyStatementByTwoPartKey
 	FindBucketRequestPayment              = findBucketRequestPayment
 	FindBucketVersioning                  = findBucketVersioning
 	FindBucketWebsite                     = findBucketWebsite
@@ -56,6 +59,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	LocalObjectETag                       = localObjectETag
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
@@ -65,6 +69,7 @@ var (
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
 	ErrCodeBucketAlreadyExists     = errCodeBucketAlreadyExists
 	ErrCodeBucketAlreadyOwnedByYou = errCodeBucketAlreadyOwnedByYou
+	ErrCodeNoSuchBucket            = errCodeNoSuchBucket
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
@@ -74,6 +79,8 @@ var (
 
 	CreateResourceID = createResourceID
 	ParseResourceID  = parseResourceID
+
+	BucketPolicyStatementParseResourceID = bucketPolicyStatementParseResourceID
 )
 
 type (
@@ -0,0 +1,546 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_objects_sync", name="Objects Sync")
+func resourceObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsSyncCreate,
+		ReadWithoutTimeout:   resourceObjectsSyncRead,
+		UpdateWithoutTimeout: resourceObjectsSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectsSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"excludes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"includes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(manager.DefaultUploadPartSize),
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: resourceObjectsSyncCustomizeDiff,
+	}
+}
+
+func resourceObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	id := objectsSyncCreateResourceID(bucket, keyPrefix)
+
+	// The metadata of any existing objects is unknown so upload every file.
+	if err := syncObjects(ctx, conn, d, nil); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	config := expandObjectsSyncConfig(d.Get)
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	etags, err := findObjectsSyncETags(ctx, conn, bucket, keyPrefix, config.isIncluded)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Objects Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	manifest := make(objectsSyncManifest, len(etags))
+	for key, etag := range etags {
+		manifest[key] = config.entry(strings.TrimPrefix(key, keyPrefix), etag)
+	}
+
+	d.Set("manifest_hash", manifest.hash())
+	d.Set("object_count", len(manifest))
+
+	return diags
+}
+
+func resourceObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	oldConfig := expandObjectsSyncConfig(func(key string) interface{} {
+		o, _ := d.GetChange(key)
+		return o
+	})
+
+	if err := syncObjects(ctx, conn, d, oldConfig); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	config := expandObjectsSyncConfig(d.Get)
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	etags, err := findObjectsSyncETags(ctx, conn, bucket, keyPrefix, config.isIncluded)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Objects Sync: %s", d.Id())
+	if err := deleteObjectsSyncKeys(ctx, conn, bucket, slices.Sorted(maps.Keys(etags))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cache_control_rule", "content_types", "excludes", "includes", "key_prefix", "part_size", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest_hash")
+		}
+	}
+
+	config := expandObjectsSyncConfig(d.Get)
+	manifest, err := config.localManifest(d.Get("key_prefix").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if hash := manifest.hash(); d.Get("manifest_hash").(string) != hash {
+		if err := d.SetNew("manifest_hash", hash); err != nil {
+			return err
+		}
+
+		return d.SetNew("object_count", len(manifest))
+	}
+
+	return nil
+}
+
+// syncObjects uploads new and changed files to the bucket and deletes objects whose files no longer exist.
+// If oldConfig is nil every file is uploaded, otherwise only files whose content, or whose metadata
+// under oldConfig, differ are uploaded. Objects selected by either configuration's patterns are eligible for deletion.
+func syncObjects(ctx context.Context, conn *s3.Client, d *schema.ResourceData, oldConfig *objectsSyncConfig) error {
+	config := expandObjectsSyncConfig(d.Get)
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+
+	manifest, err := config.localManifest(keyPrefix)
+	if err != nil {
+		return err
+	}
+
+	if v := d.Get("manifest_hash").(string); v != "" && v != manifest.hash() {
+		return fmt.Errorf("contents of %s changed after planning", config.sourceDir)
+	}
+
+	isIncluded := config.isIncluded
+	if oldConfig != nil {
+		isIncluded = func(name string) bool {
+			return config.isIncluded(name) || oldConfig.isIncluded(name)
+		}
+	}
+
+	etags, err := findObjectsSyncETags(ctx, conn, bucket, keyPrefix, isIncluded)
+	if err != nil {
+		return err
+	}
+
+	dir, err := homedir.Expand(config.sourceDir)
+	if err != nil {
+		return err
+	}
+
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = config.partSize
+	})
+
+	for _, key := range slices.Sorted(maps.Keys(manifest)) {
+		entry := manifest[key]
+
+		if etag, ok := etags[key]; ok && oldConfig != nil && oldConfig.entry(entry.name, etag) == entry {
+			continue
+		}
+
+		if err := uploadObjectsSyncEntry(ctx, uploader, dir, bucket, key, entry); err != nil {
+			return err
+		}
+	}
+
+	var toDelete []string
+	for key := range etags {
+		if _, ok := manifest[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+	slices.Sort(toDelete)
+
+	return deleteObjectsSyncKeys(ctx, conn, bucket, toDelete)
+}
+
+func uploadObjectsSyncEntry(ctx context.Context, uploader *manager.Uploader, dir, bucket, key string, entry objectsSyncEntry) error {
+	file, err := os.Open(filepath.Join(dir, filepath.FromSlash(entry.name)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	input := &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if entry.cacheControl != "" {
+		input.CacheControl = aws.String(entry.cacheControl)
+	}
+
+	if entry.contentType != "" {
+		input.ContentType = aws.String(entry.contentType)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object (%s) to Bucket (%s)", key, bucket)
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return nil
+}
+
+func deleteObjectsSyncKeys(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	for chunk := range slices.Chunk(keys, keyRequestPageSize) {
+		toDelete := make([]types.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			toDelete = append(toDelete, types.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findObjectsSyncETags returns the ETags, keyed by object key, of the objects under the key prefix
+// whose key relative to the prefix is selected by isIncluded.
+func findObjectsSyncETags(ctx context.Context, conn *s3.Client, bucket, keyPrefix string, isIncluded func(string) bool) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			key := aws.ToString(v.Key)
+			name := strings.TrimPrefix(key, keyPrefix)
+
+			if name == "" || strings.HasSuffix(name, "/") || !isIncluded(name) {
+				continue
+			}
+
+			output[key] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
+
+func objectsSyncCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator)
+}
+
+type objectsSyncCacheControlRule struct {
+	pattern string
+	value   string
+}
+
+type objectsSyncConfig struct {
+	cacheControlRules []objectsSyncCacheControlRule
+	contentTypes      map[string]string
+	excludes          []string
+	includes          []string
+	partSize          int64
+	sourceDir         string
+}
+
+// expandObjectsSyncConfig returns the sync configuration using the specified attribute getter.
+func expandObjectsSyncConfig(get func(string) interface{}) *objectsSyncConfig {
+	return &objectsSyncConfig{
+		cacheControlRules: expandObjectsSyncCacheControlRules(get("cache_control_rule").([]interface{})),
+		contentTypes:      flex.ExpandStringValueMap(get("content_types").(map[string]interface{})),
+		excludes:          flex.ExpandStringValueList(get("excludes").([]interface{})),
+		includes:          flex.ExpandStringValueList(get("includes").([]interface{})),
+		partSize:          int64(get("part_size").(int)),
+		sourceDir:         get("source_dir").(string),
+	}
+}
+
+func expandObjectsSyncCacheControlRules(tfList []interface{}) []objectsSyncCacheControlRule {
+	var apiObjects []objectsSyncCacheControlRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, objectsSyncCacheControlRule{
+			pattern: tfMap["pattern"].(string),
+			value:   tfMap[names.AttrValue].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func (c *objectsSyncConfig) isIncluded(name string) bool {
+	return tfio.IsIncluded(name, c.includes, c.excludes)
+}
+
+// entry returns the manifest entry for the file or object with the specified relative name and ETag.
+// The first matching cache control rule applies. Content types are mapped by file extension,
+// falling back to the system's MIME types.
+func (c *objectsSyncConfig) entry(name, etag string) objectsSyncEntry {
+	entry := objectsSyncEntry{
+		etag: etag,
+		name: name,
+	}
+
+	for _, rule := range c.cacheControlRules {
+		if ok, _ := tfio.MatchGlob(rule.pattern, name); ok {
+			entry.cacheControl = rule.value
+			break
+		}
+	}
+
+	ext := path.Ext(name)
+	if v, ok := c.contentTypes[ext]; ok {
+		entry.contentType = v
+	} else {
+		entry.contentType = mime.TypeByExtension(ext)
+	}
+
+	return entry
+}
+
+// localManifest returns the manifest entries, keyed by object key, for the files in the source directory.
+func (c *objectsSyncConfig) localManifest(keyPrefix string) (objectsSyncManifest, error) {
+	dir, err := homedir.Expand(c.sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := tfio.FilesInDir(dir, c.includes, c.excludes)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", c.sourceDir, err)
+	}
+
+	manifest := make(objectsSyncManifest, len(files))
+	for _, name := range files {
+		etag, err := localObjectETag(filepath.Join(dir, filepath.FromSlash(name)), c.partSize)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest[keyPrefix+name] = c.entry(name, etag)
+	}
+
+	return manifest, nil
+}
+
+type objectsSyncEntry struct {
+	cacheControl string
+	contentType  string
+	etag         string
+	name         string
+}
+
+type objectsSyncManifest map[string]objectsSyncEntry
+
+// hash returns a digest of the manifest's keys, ETags and metadata.
+func (m objectsSyncManifest) hash() string {
+	h := sha256.New()
+
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		entry := m[key]
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\n", key, entry.etag, entry.contentType, entry.cacheControl)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// localObjectETag returns the ETag S3 assigns to the specified file when uploaded with manager.Uploader using the part size.
+// Files larger than the part size are uploaded in parts and their ETag is the MD5 digest of the parts' MD5 digests
+// followed by the number of parts.
+func localObjectETag(filename string, partSize int64) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	size := fi.Size()
+
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	// Same adjustment as the uploader makes so that the file fits in the maximum number of parts.
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	var digests []byte
+	var n int
+	for {
+		h := md5.New()
+		written, err := io.CopyN(h, f, partSize)
+
+		if written > 0 {
+			digests = h.Sum(digests)
+			n++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	digest := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), n), nil
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLocalObjectETag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size     int
+		partSize int64
+		want     string
+	}{
+		"empty": {
+			size:     0,
+			partSize: 5,
+			want:     "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"single part": {
+			size:     12,
+			partSize: 12,
+			want:     "50a73d7013e9803e3b20888f8fcafb15",
+		},
+		"multipart": {
+			size:     12,
+			partSize: 5,
+			want:     "25f6b5ceed1995e06957752c0c1c23f8-3",
+		},
+		"multipart exact": {
+			size:     10,
+			partSize: 5,
+			want:     "eecf472679bdb79c2fac69fa8fcc3f30-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := make([]byte, testCase.size)
+			for i := range data {
+				data[i] = byte(i)
+			}
+
+			filename := filepath.Join(t.TempDir(), "object")
+			if err := os.WriteFile(filename, data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfs3.LocalObjectETag(filename, testCase.partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccS3ObjectsSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_objects_sync.test"
+	dir := t.TempDir()
+
+	testAccWriteObjectsSyncFile(t, dir, "index.html", "<html></html>")
+	testAccWriteObjectsSyncFile(t, dir, "css/site.css", "body {}")
+	testAccWriteObjectsSyncFile(t, dir, "notes.txt", "not synced")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "2"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=86400"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, resourceName, "site/notes.txt"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/unmanaged.txt", "text/plain", "private"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteObjectsSyncFile(t, dir, "index.html", "<html><body></body></html>")
+					testAccWriteObjectsSyncFile(t, dir, "js/app.js", "")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "object_count", "2"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/js/app.js", "application/javascript", "max-age=86400"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, resourceName, "site/css/site.css"),
+					// Objects excluded by the patterns aren't deleted.
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/unmanaged.txt", "text/plain", "private"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWriteObjectsSyncFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	filename := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckObjectsSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_sync" {
+				continue
+			}
+
+			output, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			})
+
+			if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Contents) > 0 {
+				return fmt.Errorf("S3 Objects Sync %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObject(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); !strings.EqualFold(got, contentType) {
+			return fmt.Errorf("S3 Object (%s) Content-Type: got %s, want %s", key, got, contentType)
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: got %s, want %s", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccObjectsSyncConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+  excludes   = ["*.txt"]
+
+  content_types = {
+    ".js" = "application/javascript"
+  }
+
+  cache_control_rule {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+
+  cache_control_rule {
+    pattern = "**"
+    value   = "max-age=86400"
+  }
+}
+
+resource "aws_s3_object" "unmanaged" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = "site/unmanaged.txt"
+  content       = "not managed"
+  content_type  = "text/plain"
+  cache_control = "private"
+}
+`, rName, dir)
+}
@@ -167,6 +167,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
 		},
+		{
+			Factory:  resourceBucketPolicyStatement,
+			TypeName: "aws_s3_bucket_policy_statement",
+			Name:     "Bucket Policy Statement",
+		},
 		{
 			Factory:  resourceBucketPublicAccessBlock,
 			TypeName: "aws_s3_bucket_public_access_block",
@@ -215,6 +220,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ObjectCopy",
 			},
 		},
+		{
+			Factory:  resourceObjectsSync,
+			TypeName: "aws_s3_objects_sync",
+			Name:     "Objects Sync",
+		},
 	}
 }
 
@@ -4,24 +4,27 @@
 package s3tables
 
 var (
-	NewResourceNamespace         = newResourceNamespace
-	NewResourceTable             = newResourceTable
-	NewResourceTableBucket       = newResourceTableBucket
-	NewResourceTableBucketPolicy = newResourceTableBucketPolicy
-	ResourceTablePolicy          = newResourceTablePolicy
+	NewResourceNamespace                           = newResourceNamespace
+	NewResourceTable                               = newResourceTable
+	NewResourceTableBucket                         = newResourceTableBucket
+	NewResourceTableBucketMaintenanceConfiguration = newResourceTableBucketMaintenanceConfiguration
+	NewResourceTableBucketPolicy                   = newResourceTableBucketPolicy
+	ResourceTablePolicy                            = newResourceTablePolicy
 
-	FindNamespace         = findNamespace
-	FindTable             = findTable
-	FindTableBucket       = findTableBucket
-	FindTableBucketPolicy = findTableBucketPolicy
-	FindTablePolicy       = findTablePolicy
+	FindNamespace                                = findNamespace
+	FindTable                                    = findTable
+	FindTableBucket                              = findTableBucket
+	FindTableBucketMaintenanceConfigurationValue = findTableBucketMaintenanceConfigurationValue
+	FindTableBucketPolicy                        = findTableBucketPolicy
+	FindTablePolicy                              = findTablePolicy
 
 	TableIDFromTableARN = tableIDFromTableARN
 )
 
 const (
-	ResNameNamespace   = resNameNamespace
-	ResNameTableBucket = resNameTableBucket
+	ResNameNamespace                           = resNameNamespace
+	ResNameTableBucket                         = resNameTableBucket
+	ResNameTableBucketMaintenanceConfiguration = resNameTableBucketMaintenanceConfiguration
 
 	NamespaceIDSeparator = namespaceIDSeparator
 )
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesNamespaceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	dataSourceName := "data.aws_s3tables_namespace.test"
+	resourceName := "aws_s3tables_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceDataSourceConfig_basic(rName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreatedAt, resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_by", resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrNamespace, resourceName, names.AttrNamespace),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrOwnerAccountID, resourceName, names.AttrOwnerAccountID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_bucket_arn", resourceName, "table_bucket_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespaceDataSourceConfig_basic(rName, bucketName string) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName, bucketName), `
+data "aws_s3tables_namespace" "test" {
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+}
+`)
+}
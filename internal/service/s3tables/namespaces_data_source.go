@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3tables_namespaces", name="Namespaces")
+func newDataSourceNamespaces(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceNamespaces{}, nil
+}
+
+const (
+	dsNameNamespaces = "Namespaces Data Source"
+)
+
+type dataSourceNamespaces struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceNamespaces) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_s3tables_namespaces"
+}
+
+func (d *dataSourceNamespaces) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespaces": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrPrefix: schema.StringAttribute{
+				Optional: true,
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceNamespaces) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().S3TablesClient(ctx)
+
+	var data dataSourceNamespacesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := s3tables.ListNamespacesInput{
+		Prefix:         data.Prefix.ValueStringPointer(),
+		TableBucketARN: data.TableBucketARN.ValueStringPointer(),
+	}
+
+	namespaces := make([]string, 0)
+	pages := s3tables.NewListNamespacesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameNamespaces, data.TableBucketARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		for _, v := range page.Namespaces {
+			// Namespaces are single-level, so only the first element is relevant.
+			if len(v.Namespace) > 0 {
+				namespaces = append(namespaces, v.Namespace[0])
+			}
+		}
+	}
+
+	data.Namespaces = flex.FlattenFrameworkStringValueListOfString(ctx, namespaces)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceNamespacesModel struct {
+	Namespaces     fwtypes.ListOfString `tfsdk:"namespaces"`
+	Prefix         types.String         `tfsdk:"prefix"`
+	TableBucketARN fwtypes.ARN          `tfsdk:"table_bucket_arn"`
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesNamespacesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	dataSourceName := "data.aws_s3tables_namespaces.test"
+	resourceName := "aws_s3tables_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespacesDataSourceConfig_basic(rName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "namespaces.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "namespaces.0", resourceName, names.AttrNamespace),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespacesDataSourceConfig_basic(rName, bucketName string) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName, bucketName), `
+data "aws_s3tables_namespaces" "test" {
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+
+  depends_on = [aws_s3tables_namespace.test]
+}
+`)
+}
@@ -15,7 +15,38 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newDataSourceNamespace,
+			TypeName: "aws_s3tables_namespace",
+			Name:     "Namespace",
+		},
+		{
+			Factory:  newDataSourceNamespaces,
+			TypeName: "aws_s3tables_namespaces",
+			Name:     "Namespaces",
+		},
+		{
+			Factory:  newDataSourceTable,
+			TypeName: "aws_s3tables_table",
+			Name:     "Table",
+		},
+		{
+			Factory:  newDataSourceTableBucket,
+			TypeName: "aws_s3tables_table_bucket",
+			Name:     "Table Bucket",
+		},
+		{
+			Factory:  newDataSourceTableBuckets,
+			TypeName: "aws_s3tables_table_buckets",
+			Name:     "Table Buckets",
+		},
+		{
+			Factory:  newDataSourceTables,
+			TypeName: "aws_s3tables_tables",
+			Name:     "Tables",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -35,6 +66,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_s3tables_table_bucket",
 			Name:     "Table Bucket",
 		},
+		{
+			Factory:  newResourceTableBucketMaintenanceConfiguration,
+			TypeName: "aws_s3tables_table_bucket_maintenance_configuration",
+			Name:     "Table Bucket Maintenance Configuration",
+		},
 		{
 			Factory:  newResourceTableBucketPolicy,
 			TypeName: "aws_s3tables_table_bucket_policy",
@@ -14,11 +14,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -142,6 +145,65 @@ func (r *resourceTable) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"metadata": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableMetadataModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"iceberg": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[icebergMetadataModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrSchema: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[icebergSchemaModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												names.AttrField: schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[icebergSchemaFieldModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+															},
+															"required": schema.BoolAttribute{
+																Optional: true,
+																Computed: true,
+																Default:  booldefault.StaticBool(false),
+															},
+															names.AttrType: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -160,6 +222,15 @@ func (r *resourceTable) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if !plan.Metadata.IsNull() {
+		metadata, d := expandTableMetadata(ctx, plan.Metadata)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.Metadata = metadata
+	}
+
 	_, err := conn.CreateTable(ctx, &input)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -521,6 +592,7 @@ type resourceTableModel struct {
 	CreatedBy                types.String                                              `tfsdk:"created_by"`
 	Format                   fwtypes.StringEnum[awstypes.OpenTableFormat]              `tfsdk:"format"`
 	MaintenanceConfiguration fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration" autoflex:"-"`
+	Metadata                 fwtypes.ListNestedObjectValueOf[tableMetadataModel]       `tfsdk:"metadata" autoflex:"-"`
 	MetadataLocation         types.String                                              `tfsdk:"metadata_location"`
 	ModifiedAt               timetypes.RFC3339                                         `tfsdk:"modified_at"`
 	ModifiedBy               types.String                                              `tfsdk:"modified_by"`
@@ -533,6 +605,24 @@ type resourceTableModel struct {
 	WarehouseLocation        types.String                                              `tfsdk:"warehouse_location"`
 }
 
+type tableMetadataModel struct {
+	Iceberg fwtypes.ListNestedObjectValueOf[icebergMetadataModel] `tfsdk:"iceberg"`
+}
+
+type icebergMetadataModel struct {
+	Schema fwtypes.ListNestedObjectValueOf[icebergSchemaModel] `tfsdk:"schema"`
+}
+
+type icebergSchemaModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[icebergSchemaFieldModel] `tfsdk:"field"`
+}
+
+type icebergSchemaFieldModel struct {
+	Name     types.String `tfsdk:"name"`
+	Required types.Bool   `tfsdk:"required"`
+	Type     types.String `tfsdk:"type"`
+}
+
 type tableMaintenanceConfigurationModel struct {
 	IcebergCompaction         fwtypes.ObjectValueOf[tableMaintenanceConfigurationValueModel[icebergCompactionSettingsModel]]         `tfsdk:"iceberg_compaction"`
 	IcebergSnapshotManagement fwtypes.ObjectValueOf[tableMaintenanceConfigurationValueModel[icebergSnapshotManagementSettingsModel]] `tfsdk:"iceberg_snapshot_management"`
@@ -552,6 +642,30 @@ type icebergSnapshotManagementSettingsModel struct {
 	MinSnapshotsToKeep  types.Int32 `tfsdk:"min_snapshots_to_keep"`
 }
 
+func expandTableMetadata(ctx context.Context, in fwtypes.ListNestedObjectValueOf[tableMetadataModel]) (result awstypes.TableMetadata, diags diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-expander-functions
+	model, d := in.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || model == nil {
+		return result, diags
+	}
+
+	iceberg, d := model.Iceberg.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || iceberg == nil {
+		return result, diags
+	}
+
+	var value awstypes.IcebergMetadata
+	diags.Append(flex.Expand(ctx, iceberg, &value)...)
+	if diags.HasError() {
+		return result, diags
+	}
+
+	return &awstypes.TableMetadataMemberIceberg{
+		Value: value,
+	}, diags
+}
+
 func flattenTableMaintenanceConfiguration(ctx context.Context, in *s3tables.GetTableMaintenanceConfigurationOutput) (result fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel], diags diag.Diagnostics) { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
 	compactionConfig := in.Configuration[string(awstypes.TableMaintenanceTypeIcebergCompaction)]
 	compactionConfigModel, d := flattenTableMaintenanceIcebergCompaction(ctx, &compactionConfig)
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3tables_table_bucket", name="Table Bucket")
+func newDataSourceTableBucket(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTableBucket{}, nil
+}
+
+const (
+	dsNameTableBucket = "Table Bucket Data Source"
+)
+
+type dataSourceTableBucket struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTableBucket) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_s3tables_table_bucket"
+}
+
+func (d *dataSourceTableBucket) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"maintenance_configuration": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableBucketMaintenanceConfigurationModel](ctx),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceTableBucket) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrARN),
+			path.MatchRoot(names.AttrName),
+		),
+	}
+}
+
+func (d *dataSourceTableBucket) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().S3TablesClient(ctx)
+
+	var data dataSourceTableBucketModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arn := data.ARN.ValueString()
+	if data.ARN.IsNull() {
+		name := data.Name.ValueString()
+		bucket, err := findTableBucketSummaryByName(ctx, conn, name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTableBucket, name, err),
+				err.Error(),
+			)
+			return
+		}
+		arn = aws.ToString(bucket.Arn)
+	}
+
+	out, err := findTableBucket(ctx, conn, arn)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTableBucket, arn, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsMaintenanceConfig, err := conn.GetTableBucketMaintenanceConfiguration(ctx, &s3tables.GetTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTableBucket, arn, err),
+			err.Error(),
+		)
+		return
+	}
+	maintenanceConfiguration, diags := flattenTableBucketMaintenanceConfiguration(ctx, awsMaintenanceConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.MaintenanceConfiguration = maintenanceConfiguration
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findTableBucketSummaryByName(ctx context.Context, conn *s3tables.Client, name string) (*awstypes.TableBucketSummary, error) {
+	in := s3tables.ListTableBucketsInput{
+		Prefix: aws.String(name),
+	}
+
+	pages := s3tables.NewListTableBucketsPaginator(conn, &in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TableBuckets {
+			if aws.ToString(v.Name) == name {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type dataSourceTableBucketModel struct {
+	ARN                      fwtypes.ARN                                                     `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                               `tfsdk:"created_at"`
+	MaintenanceConfiguration fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration" autoflex:"-"`
+	Name                     types.String                                                    `tfsdk:"name"`
+	OwnerAccountID           types.String                                                    `tfsdk:"owner_account_id"`
+}
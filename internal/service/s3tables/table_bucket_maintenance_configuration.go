@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3tables_table_bucket_maintenance_configuration", name="Table Bucket Maintenance Configuration")
+func newResourceTableBucketMaintenanceConfiguration(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTableBucketMaintenanceConfiguration{}, nil
+}
+
+const (
+	resNameTableBucketMaintenanceConfiguration = "Table Bucket Maintenance Configuration"
+)
+
+type resourceTableBucketMaintenanceConfiguration struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_s3tables_table_bucket_maintenance_configuration"
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			// TODO: Once Protocol v6 is supported, convert this to a `schema.SingleNestedAttribute` with full schema information
+			// Validations needed:
+			// * settings.non_current_days:  int32validator.AtLeast(1)
+			// * settings.unreferenced_days: int32validator.AtLeast(1)
+			"iceberg_unreferenced_file_removal": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableBucketMaintenanceConfigurationValueModel[icebergUnreferencedFileRemovalSettingsModel]](ctx),
+				Required:   true,
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().S3TablesClient(ctx)
+
+	var plan resourceTableBucketMaintenanceConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, d := expandTableBucketMaintenanceIcebergUnreferencedFileRemoval(ctx, plan.IcebergUnreferencedFileRemoval)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := s3tables.PutTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: plan.TableBucketARN.ValueStringPointer(),
+		Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+		Value:          &value,
+	}
+
+	_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionCreating, resNameTableBucketMaintenanceConfiguration, plan.TableBucketARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().S3TablesClient(ctx)
+
+	var state resourceTableBucketMaintenanceConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTableBucketMaintenanceConfigurationValue(ctx, conn, state.TableBucketARN.ValueString(), awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, resNameTableBucketMaintenanceConfiguration, state.TableBucketARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	value, d := flattenTableBucketMaintenanceIcebergUnreferencedFileRemoval(ctx, out)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IcebergUnreferencedFileRemoval = value
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().S3TablesClient(ctx)
+
+	var plan, state resourceTableBucketMaintenanceConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IcebergUnreferencedFileRemoval.Equal(state.IcebergUnreferencedFileRemoval) {
+		value, d := expandTableBucketMaintenanceIcebergUnreferencedFileRemoval(ctx, plan.IcebergUnreferencedFileRemoval)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input := s3tables.PutTableBucketMaintenanceConfigurationInput{
+			TableBucketARN: plan.TableBucketARN.ValueStringPointer(),
+			Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+			Value:          &value,
+		}
+
+		_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionUpdating, resNameTableBucketMaintenanceConfiguration, plan.TableBucketARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables unreferenced file removal, as maintenance configurations cannot be removed from a table bucket.
+func (r *resourceTableBucketMaintenanceConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().S3TablesClient(ctx)
+
+	var state resourceTableBucketMaintenanceConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, d := expandTableBucketMaintenanceIcebergUnreferencedFileRemoval(ctx, state.IcebergUnreferencedFileRemoval)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	value.Status = awstypes.MaintenanceStatusDisabled
+
+	input := s3tables.PutTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: state.TableBucketARN.ValueStringPointer(),
+		Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+		Value:          &value,
+	}
+
+	_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, &input)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionDeleting, resNameTableBucketMaintenanceConfiguration, state.TableBucketARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceTableBucketMaintenanceConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("table_bucket_arn"), req, resp)
+}
+
+func findTableBucketMaintenanceConfigurationValue(ctx context.Context, conn *s3tables.Client, tableBucketARN string, maintenanceType awstypes.TableBucketMaintenanceType) (*awstypes.TableBucketMaintenanceConfigurationValue, error) {
+	in := s3tables.GetTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	out, err := conn.GetTableBucketMaintenanceConfiguration(ctx, &in)
+	if err != nil {
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	value, ok := out.Configuration[string(maintenanceType)]
+	if !ok {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return &value, nil
+}
+
+type resourceTableBucketMaintenanceConfigurationModel struct {
+	IcebergUnreferencedFileRemoval fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationValueModel[icebergUnreferencedFileRemovalSettingsModel]] `tfsdk:"iceberg_unreferenced_file_removal"`
+	TableBucketARN                 fwtypes.ARN                                                                                                       `tfsdk:"table_bucket_arn"`
+}
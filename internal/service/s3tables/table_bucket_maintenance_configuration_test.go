@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfs3tables "github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTableBucketMaintenanceConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3tables_table_bucket_maintenance_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableBucketMaintenanceConfigurationConfig_basic(rName, awstypes.MaintenanceStatusEnabled, 20, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableBucketMaintenanceConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("iceberg_unreferenced_file_removal"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"settings": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"non_current_days":  knownvalue.Int32Exact(20),
+							"unreferenced_days": knownvalue.Int32Exact(6),
+						}),
+						names.AttrStatus: tfknownvalue.StringExact(awstypes.MaintenanceStatusEnabled),
+					})),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "table_bucket_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "table_bucket_arn",
+			},
+			{
+				Config: testAccTableBucketMaintenanceConfigurationConfig_basic(rName, awstypes.MaintenanceStatusDisabled, 15, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableBucketMaintenanceConfigurationExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("iceberg_unreferenced_file_removal"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"settings": knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"non_current_days":  knownvalue.Int32Exact(15),
+							"unreferenced_days": knownvalue.Int32Exact(4),
+						}),
+						names.AttrStatus: tfknownvalue.StringExact(awstypes.MaintenanceStatusDisabled),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTableBucketMaintenanceConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.S3Tables, create.ErrActionCheckingExistence, tfs3tables.ResNameTableBucketMaintenanceConfiguration, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		_, err := tfs3tables.FindTableBucketMaintenanceConfigurationValue(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval)
+		if err != nil {
+			return create.Error(names.S3Tables, create.ErrActionCheckingExistence, tfs3tables.ResNameTableBucketMaintenanceConfiguration, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableBucketMaintenanceConfigurationConfig_basic(rName string, status awstypes.MaintenanceStatus, nonCurrentDays, unreferencedDays int32) string {
+	return fmt.Sprintf(`
+resource "aws_s3tables_table_bucket_maintenance_configuration" "test" {
+  table_bucket_arn = aws_s3tables_table_bucket.test.arn
+
+  iceberg_unreferenced_file_removal = {
+    settings = {
+      non_current_days  = %[3]d
+      unreferenced_days = %[4]d
+    }
+    status = %[2]q
+  }
+}
+
+resource "aws_s3tables_table_bucket" "test" {
+  name = %[1]q
+}
+`, rName, status, nonCurrentDays, unreferencedDays)
+}
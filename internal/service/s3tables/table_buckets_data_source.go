@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3tables_table_buckets", name="Table Buckets")
+func newDataSourceTableBuckets(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTableBuckets{}, nil
+}
+
+const (
+	dsNameTableBuckets = "Table Buckets Data Source"
+)
+
+type dataSourceTableBuckets struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTableBuckets) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_s3tables_table_buckets"
+}
+
+func (d *dataSourceTableBuckets) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrPrefix: schema.StringAttribute{
+				Optional: true,
+			},
+			"table_buckets": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableBucketSummaryModel](ctx),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceTableBuckets) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().S3TablesClient(ctx)
+
+	var data dataSourceTableBucketsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := s3tables.ListTableBucketsInput{
+		Prefix: data.Prefix.ValueStringPointer(),
+	}
+
+	var buckets []awstypes.TableBucketSummary
+	pages := s3tables.NewListTableBucketsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTableBuckets, data.Prefix.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		buckets = append(buckets, page.TableBuckets...)
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, buckets, &data.TableBuckets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceTableBucketsModel struct {
+	Prefix       types.String                                             `tfsdk:"prefix"`
+	TableBuckets fwtypes.ListNestedObjectValueOf[tableBucketSummaryModel] `tfsdk:"table_buckets"`
+}
+
+type tableBucketSummaryModel struct {
+	ARN            types.String      `tfsdk:"arn"`
+	CreatedAt      timetypes.RFC3339 `tfsdk:"created_at"`
+	Name           types.String      `tfsdk:"name"`
+	OwnerAccountID types.String      `tfsdk:"owner_account_id"`
+}
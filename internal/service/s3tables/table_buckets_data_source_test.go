@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTableBucketsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3tables_table_buckets.test"
+	resourceName := "aws_s3tables_table_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableBucketsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "table_buckets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_buckets.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_buckets.0.created_at", resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_buckets.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_buckets.0.owner_account_id", resourceName, names.AttrOwnerAccountID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableBucketsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableBucketConfig_basic(rName), fmt.Sprintf(`
+data "aws_s3tables_table_buckets" "test" {
+  prefix = %[1]q
+
+  depends_on = [aws_s3tables_table_bucket.test]
+}
+`, rName))
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3tables_table", name="Table")
+func newDataSourceTable(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTable{}, nil
+}
+
+const (
+	dsNameTable = "Table Data Source"
+)
+
+type dataSourceTable struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTable) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_s3tables_table"
+}
+
+func (d *dataSourceTable) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OpenTableFormat](),
+				Computed:   true,
+			},
+			"metadata_location": schema.StringAttribute{
+				Computed: true,
+			},
+			"modified_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"modified_by": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TableType](),
+				Computed:   true,
+			},
+			"version_token": schema.StringAttribute{
+				Computed: true,
+			},
+			"warehouse_location": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceTable) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().S3TablesClient(ctx)
+
+	var data dataSourceTableModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTable(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTable, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data, flex.WithFieldNamePrefix("Table"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceTableModel struct {
+	ARN               types.String                                 `tfsdk:"arn"`
+	CreatedAt         timetypes.RFC3339                            `tfsdk:"created_at"`
+	CreatedBy         types.String                                 `tfsdk:"created_by"`
+	Format            fwtypes.StringEnum[awstypes.OpenTableFormat] `tfsdk:"format"`
+	MetadataLocation  types.String                                 `tfsdk:"metadata_location"`
+	ModifiedAt        timetypes.RFC3339                            `tfsdk:"modified_at"`
+	ModifiedBy        types.String                                 `tfsdk:"modified_by"`
+	Name              types.String                                 `tfsdk:"name"`
+	Namespace         types.String                                 `tfsdk:"namespace" autoflex:",noflatten"` // On read, Namespace is an array
+	OwnerAccountID    types.String                                 `tfsdk:"owner_account_id"`
+	TableBucketARN    fwtypes.ARN                                  `tfsdk:"table_bucket_arn"`
+	Type              fwtypes.StringEnum[awstypes.TableType]       `tfsdk:"type"`
+	VersionToken      types.String                                 `tfsdk:"version_token"`
+	WarehouseLocation types.String                                 `tfsdk:"warehouse_location"`
+}
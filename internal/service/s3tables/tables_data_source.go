@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_s3tables_tables", name="Tables")
+func newDataSourceTables(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTables{}, nil
+}
+
+const (
+	dsNameTables = "Tables Data Source"
+)
+
+type dataSourceTables struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTables) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_s3tables_tables"
+}
+
+func (d *dataSourceTables) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrNamespace: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrPrefix: schema.StringAttribute{
+				Optional: true,
+			},
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"tables": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tableSummaryModel](ctx),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceTables) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().S3TablesClient(ctx)
+
+	var data dataSourceTablesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := s3tables.ListTablesInput{
+		Namespace:      data.Namespace.ValueStringPointer(),
+		Prefix:         data.Prefix.ValueStringPointer(),
+		TableBucketARN: data.TableBucketARN.ValueStringPointer(),
+	}
+
+	tables := make([]tableSummaryModel, 0)
+	pages := s3tables.NewListTablesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3Tables, create.ErrActionReading, dsNameTables, data.TableBucketARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		for _, v := range page.Tables {
+			tables = append(tables, flattenTableSummary(v))
+		}
+	}
+
+	list, diags := fwtypes.NewListNestedObjectValueOfValueSlice(ctx, tables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tables = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenTableSummary is hand-written as the API returns the namespace as an array.
+func flattenTableSummary(apiObject awstypes.TableSummary) tableSummaryModel { // nosemgrep:ci.semgrep.framework.manual-flattener-functions
+	model := tableSummaryModel{
+		ARN:        types.StringPointerValue(apiObject.TableARN),
+		CreatedAt:  timetypes.NewRFC3339TimePointerValue(apiObject.CreatedAt),
+		ModifiedAt: timetypes.NewRFC3339TimePointerValue(apiObject.ModifiedAt),
+		Name:       types.StringPointerValue(apiObject.Name),
+		Namespace:  types.StringNull(),
+		Type:       fwtypes.StringEnumValue(apiObject.Type),
+	}
+
+	if len(apiObject.Namespace) > 0 {
+		model.Namespace = types.StringValue(apiObject.Namespace[0])
+	}
+
+	return model
+}
+
+type dataSourceTablesModel struct {
+	Namespace      types.String                                       `tfsdk:"namespace"`
+	Prefix         types.String                                       `tfsdk:"prefix"`
+	TableBucketARN fwtypes.ARN                                        `tfsdk:"table_bucket_arn"`
+	Tables         fwtypes.ListNestedObjectValueOf[tableSummaryModel] `tfsdk:"tables"`
+}
+
+type tableSummaryModel struct {
+	ARN        types.String                           `tfsdk:"arn"`
+	CreatedAt  timetypes.RFC3339                      `tfsdk:"created_at"`
+	ModifiedAt timetypes.RFC3339                      `tfsdk:"modified_at"`
+	Name       types.String                           `tfsdk:"name"`
+	Namespace  types.String                           `tfsdk:"namespace"`
+	Type       fwtypes.StringEnum[awstypes.TableType] `tfsdk:"type"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTablesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	namespace := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	dataSourceName := "data.aws_s3tables_tables.test"
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTablesDataSourceConfig_basic(rName, namespace, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tables.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tables.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tables.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tables.0.namespace", resourceName, names.AttrNamespace),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tables.0.type", resourceName, names.AttrType),
+				),
+			},
+		},
+	})
+}
+
+func testAccTablesDataSourceConfig_basic(rName, namespace, bucketName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_basic(rName, namespace, bucketName), `
+data "aws_s3tables_tables" "test" {
+  namespace        = aws_s3tables_table.test.namespace
+  table_bucket_arn = aws_s3tables_table.test.table_bucket_arn
+
+  depends_on = [aws_s3tables_table.test]
+}
+`)
+}
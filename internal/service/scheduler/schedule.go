@@ -15,6 +15,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -41,7 +42,7 @@ func resourceSchedule() *schema.Resource {
 		DeleteWithoutTimeout: resourceScheduleDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceScheduleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -647,6 +648,20 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+// resourceScheduleImport accepts an ID of the form "group_name/schedule_name", the name of a schedule
+// in the default schedule group, or a schedule ARN.
+func resourceScheduleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := ResourceScheduleParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string) (*scheduler.GetScheduleOutput, error) {
 	in := &scheduler.GetScheduleInput{
 		GroupName: aws.String(groupName),
@@ -700,6 +715,25 @@ func ResourceScheduleParseID(id string) (groupName, scheduleName string, err err
 	return parts[0], parts[1], nil
 }
 
+// ResourceScheduleParseImportID returns the resource ID, of the form "group_name/schedule_name",
+// for the given import ID. A schedule name without a group name is in the default schedule group.
+func ResourceScheduleParseImportID(id string) (string, error) {
+	if arn.IsARN(id) {
+		return ResourceScheduleIDFromARN(id)
+	}
+
+	resourceID := id
+	if id != "" && !strings.Contains(id, "/") {
+		resourceID = fmt.Sprintf("%s/%s", DefaultScheduleGroupName, id)
+	}
+
+	if _, _, err := ResourceScheduleParseID(resourceID); err != nil {
+		return "", fmt.Errorf("unexpected format for ID (%s), expected GROUPNAME/SCHEDULENAME, SCHEDULENAME or a schedule ARN", id)
+	}
+
+	return resourceID, nil
+}
+
 func sagemakerPipelineParameterHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(fmt.Sprintf("%s-%s", m[names.AttrName].(string), m[names.AttrValue].(string)))
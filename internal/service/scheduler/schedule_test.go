@@ -176,6 +176,71 @@ func TestResourceScheduleParseID(t *testing.T) {
 	}
 }
 
+func TestResourceScheduleParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ImportID string
+		ID       string
+		Fails    bool
+	}{
+		{
+			ImportID: "test",
+			ID:       "default/test",
+		},
+		{
+			ImportID: "default/test",
+			ID:       "default/test",
+		},
+		{
+			ImportID: "my-group/test",
+			ID:       "my-group/test",
+		},
+		{
+			ImportID: "arn:aws:scheduler:eu-west-1:735669964269:schedule/my-group/test", //lintignore:AWSAT003,AWSAT005
+			ID:       "my-group/test",
+		},
+		{
+			ImportID: "arn:aws:scheduler:eu-west-1:735669964269:schedule/my-group", //lintignore:AWSAT003,AWSAT005
+			Fails:    true,
+		},
+		{
+			ImportID: "my-group/test/test",
+			Fails:    true,
+		},
+		{
+			ImportID: "my-group/",
+			Fails:    true,
+		},
+		{
+			ImportID: "",
+			Fails:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ImportID, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := tfscheduler.ResourceScheduleParseImportID(tc.ImportID)
+
+			if tc.Fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+
+			if id != tc.ID {
+				t.Errorf("expected id %s, got: %s", tc.ID, id)
+			}
+		})
+	}
+}
+
 func TestAccSchedulerSchedule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -231,6 +296,18 @@ func TestAccSchedulerSchedule_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
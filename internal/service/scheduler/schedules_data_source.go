@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_scheduler_schedules", name="Schedules")
+func dataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrGroupName: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 64)),
+			},
+			names.AttrNamePrefix: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 64)),
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrGroupName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+			},
+		},
+	}
+}
+
+const (
+	DSNameSchedules = "Schedules Data Source"
+)
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.scheduler-in-func-name
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	in := &scheduler.ListSchedulesInput{}
+
+	if v, ok := d.GetOk(names.AttrGroupName); ok {
+		in.GroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
+		in.NamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrState); ok {
+		in.State = types.ScheduleState(v.(string))
+	}
+
+	out, err := findSchedules(ctx, conn, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, DSNameSchedules, "", err)
+	}
+
+	var tfList []interface{}
+	for _, v := range out {
+		tfMap := map[string]interface{}{
+			names.AttrARN:       aws.ToString(v.Arn),
+			names.AttrGroupName: aws.ToString(v.GroupName),
+			names.AttrName:      aws.ToString(v.Name),
+			names.AttrState:     string(v.State),
+		}
+
+		if v.Target != nil {
+			tfMap["target_arn"] = aws.ToString(v.Target.Arn)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+
+	if err := d.Set("schedules", tfList); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionSetting, DSNameSchedules, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSchedules(ctx context.Context, conn *scheduler.Client, in *scheduler.ListSchedulesInput) ([]types.ScheduleSummary, error) {
+	var out []types.ScheduleSummary
+
+	pages := scheduler.NewListSchedulesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.Schedules...)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "schedules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "schedules.*", map[string]string{
+						names.AttrGroupName: name,
+						names.AttrName:      name + "-0",
+						names.AttrState:     "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.target_arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.disabled", "schedules.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.disabled", "schedules.0.name", name+"-1"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.prefix", "schedules.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_scheduler_schedules.prefix", "schedules.0.name", name+"-0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig_basic(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule" "test" {
+  count = 2
+
+  name       = "%[1]s-${count.index}"
+  group_name = aws_scheduler_schedule_group.test.name
+  state      = count.index == 0 ? "ENABLED" : "DISABLED"
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+data "aws_scheduler_schedules" "test" {
+  group_name = aws_scheduler_schedule_group.test.name
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+
+data "aws_scheduler_schedules" "disabled" {
+  group_name = aws_scheduler_schedule_group.test.name
+  state      = "DISABLED"
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+
+data "aws_scheduler_schedules" "prefix" {
+  group_name  = aws_scheduler_schedule_group.test.name
+  name_prefix = "%[1]s-0"
+
+  depends_on = [aws_scheduler_schedule.test]
+}
+`, name),
+	)
+}
@@ -55,7 +55,7 @@ func sweepScheduleGroups(region string) error {
 		for _, it := range page.ScheduleGroups {
 			name := aws.ToString(it.Name)
 
-			if name == "default" {
+			if name == DefaultScheduleGroupName {
 				// Can't delete the default schedule group.
 				continue
 			}
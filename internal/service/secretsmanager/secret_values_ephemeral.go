@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSecretValues = "Secret Values Ephemeral Resource"
+)
+
+// @EphemeralResource(aws_secretsmanager_secret_values, name="Secret Values")
+func newEphemeralSecretValues(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecretValues{}, nil
+}
+
+type ephemeralSecretValues struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSecretValues) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_secret_values"
+}
+
+func (e *ephemeralSecretValues) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"secret_ids": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 20),
+					listvalidator.ExactlyOneOf(path.MatchRoot(names.AttrFilter)),
+				},
+			},
+			"secret_values": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[secretValueEntryModel](ctx),
+				Computed:   true,
+				Sensitive:  true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[secretValuesFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FilterNameStringType](),
+							Required:   true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType: fwtypes.ListOfStringType,
+							Required:   true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecretValues) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data secretValuesEphemeralResourceModel
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input secretsmanager.BatchGetSecretValueInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findSecretValues(ctx, conn, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, ERNameSecretValues, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	var entries []secretValueEntryModel
+	for _, v := range output {
+		var entry secretValueEntryModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, v, &entry)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		entry.SecretBinaryBase64 = fwflex.StringValueToFramework(ctx, itypes.Base64EncodeOnce(v.SecretBinary))
+		entry.SecretMap = flattenSecretMap(ctx, aws.ToString(v.SecretString))
+
+		entries = append(entries, entry)
+	}
+
+	data.SecretValues = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, entries)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+func findSecretValues(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.BatchGetSecretValueInput) ([]awstypes.SecretValueEntry, error) {
+	var output []awstypes.SecretValueEntry
+
+	pages := secretsmanager.NewBatchGetSecretValuePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Errors for individual secrets are returned alongside the values that could be retrieved.
+		var errs []error
+		for _, v := range page.Errors {
+			errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(v.SecretId), aws.ToString(v.ErrorCode), aws.ToString(v.Message)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SecretValues...)
+	}
+
+	return output, nil
+}
+
+type secretValuesEphemeralResourceModel struct {
+	Filters      fwtypes.ListNestedObjectValueOf[secretValuesFilterModel] `tfsdk:"filter"`
+	SecretIDList fwtypes.ListValueOf[types.String]                        `tfsdk:"secret_ids"`
+	SecretValues fwtypes.ListNestedObjectValueOf[secretValueEntryModel]   `tfsdk:"secret_values"`
+}
+
+type secretValuesFilterModel struct {
+	Key    fwtypes.StringEnum[awstypes.FilterNameStringType] `tfsdk:"key"`
+	Values fwtypes.ListValueOf[types.String]                 `tfsdk:"values"`
+}
+
+type secretValueEntryModel struct {
+	ARN                types.String                      `tfsdk:"arn"`
+	CreatedDate        timetypes.RFC3339                 `tfsdk:"created_date"`
+	Name               types.String                      `tfsdk:"name"`
+	SecretBinaryBase64 types.String                      `tfsdk:"secret_binary_base64"`
+	SecretMap          fwtypes.MapValueOf[types.String]  `tfsdk:"secret_map"`
+	SecretString       types.String                      `tfsdk:"secret_string"`
+	VersionID          types.String                      `tfsdk:"version_id"`
+	VersionStages      fwtypes.ListValueOf[types.String] `tfsdk:"version_stages"`
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretValuesEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values").AtSliceIndex(0).AtMapKey("secret_map"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"key": knownvalue.StringExact("value"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretValuesEphemeral_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesEphemeralResourceConfig_filter(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values"), knownvalue.ListSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretValuesEphemeral_secretBinary(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesEphemeralResourceConfig_secretBinary(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values").AtSliceIndex(0).AtMapKey("secret_binary_base64"), knownvalue.StringExact("/wD+")),
+				},
+			},
+		},
+	})
+}
+
+func testAccSecretValuesEphemeralResourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  count = 2
+
+  secret_id     = aws_secretsmanager_secret.test[count.index].id
+  secret_string = jsonencode({ key = "value" })
+}
+`, rName)
+}
+
+func testAccSecretValuesEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSecretValuesEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_values.test"),
+		`
+ephemeral "aws_secretsmanager_secret_values" "test" {
+  secret_ids = aws_secretsmanager_secret_version.test[*].secret_id
+}
+`)
+}
+
+func testAccSecretValuesEphemeralResourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSecretValuesEphemeralResourceConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_values.test"),
+		fmt.Sprintf(`
+ephemeral "aws_secretsmanager_secret_values" "test" {
+  filter {
+    key    = "name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
+
+func testAccSecretValuesEphemeralResourceConfig_secretBinary(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_values.test"),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+# Not valid UTF-8.
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_binary = "/wD+"
+}
+
+ephemeral "aws_secretsmanager_secret_values" "test" {
+  secret_ids = [aws_secretsmanager_secret_version.test.secret_id]
+}
+`, rName))
+}
@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -353,3 +355,26 @@ func findSecretVersionByTwoPartKey(ctx context.Context, conn *secretsmanager.Cli
 
 	return findSecretVersion(ctx, conn, input)
 }
+
+// secretStringToMap returns the top-level key/value pairs of a secret string containing a JSON object.
+// Non-string values are returned in their JSON-encoded form.
+// A nil map is returned if the secret string is not a JSON object.
+func secretStringToMap(secretString string) map[string]string {
+	var m map[string]json.RawMessage
+
+	if err := tfjson.DecodeFromString(secretString, &m); err != nil || m == nil {
+		return nil
+	}
+
+	apiObject := make(map[string]string, len(m))
+	for k, raw := range m {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			apiObject[k] = s
+		} else {
+			apiObject[k] = string(raw)
+		}
+	}
+
+	return apiObject
+}
@@ -39,6 +39,12 @@ func dataSourceSecretVersion() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"secret_map": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
 			"secret_string": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -95,6 +101,7 @@ func dataSourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set(names.AttrCreatedDate, aws.String(output.CreatedDate.Format(time.RFC3339)))
 	d.Set("secret_id", secretID)
 	d.Set("secret_binary", string(output.SecretBinary))
+	d.Set("secret_map", secretStringToMap(aws.ToString(output.SecretString)))
 	d.Set("secret_string", output.SecretString)
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
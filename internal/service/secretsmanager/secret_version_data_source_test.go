@@ -82,6 +82,35 @@ func TestAccSecretsManagerSecretVersionDataSource_versionStage(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersionDataSource_secretMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionDataSourceConfig_secretMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "secret_map.%", "3"),
+					resource.TestCheckResourceAttr(datasourceName, "secret_map.username", "admin"),
+					resource.TestCheckResourceAttr(datasourceName, "secret_map.password", "s3cr3t"),
+					resource.TestCheckResourceAttr(datasourceName, "secret_map.port", "5432"),
+				),
+			},
+			{
+				Config: testAccSecretVersionDataSourceConfig_stageDefault(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(datasourceName, "secret_map.%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecretVersionCheckDataSource(datasourceName, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		dataSource, ok := s.RootModule().Resources[datasourceName]
@@ -173,3 +202,24 @@ data "aws_secretsmanager_secret_version" "test" {
 }
 `, rName)
 }
+
+func testAccSecretVersionDataSourceConfig_secretMap(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    username = "admin"
+    password = "s3cr3t"
+    port     = 5432
+  })
+}
+
+data "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+}
+`, rName)
+}
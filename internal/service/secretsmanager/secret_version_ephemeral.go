@@ -6,8 +6,10 @@ package secretsmanager
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -46,6 +48,11 @@ func (e *ephemeralSecrets) Schema(ctx context.Context, _ ephemeral.SchemaRequest
 				Computed:  true,
 				Sensitive: true,
 			},
+			"secret_map": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+				Sensitive:  true,
+			},
 			"secret_string": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
@@ -96,6 +103,7 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 	}
 
 	data.SecretBinary = fwflex.StringValueToFramework(ctx, string(output.SecretBinary))
+	data.SecretMap = flattenSecretMap(ctx, aws.ToString(output.SecretString))
 
 	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
 }
@@ -105,8 +113,23 @@ type epSecretVersionData struct {
 	CreatedDate   timetypes.RFC3339                 `tfsdk:"created_date"`
 	SecretID      types.String                      `tfsdk:"secret_id"`
 	SecretBinary  types.String                      `tfsdk:"secret_binary"`
+	SecretMap     fwtypes.MapValueOf[types.String]  `tfsdk:"secret_map"`
 	SecretString  types.String                      `tfsdk:"secret_string"`
 	VersionID     types.String                      `tfsdk:"version_id"`
 	VersionStage  types.String                      `tfsdk:"version_stage"`
 	VersionStages fwtypes.ListValueOf[types.String] `tfsdk:"version_stages"`
 }
+
+func flattenSecretMap(ctx context.Context, secretString string) fwtypes.MapValueOf[types.String] {
+	m := secretStringToMap(secretString)
+	if m == nil {
+		return fwtypes.NewMapValueOfNull[types.String](ctx)
+	}
+
+	elements := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+
+	return fwtypes.NewMapValueOfMust[types.String](ctx, elements)
+}
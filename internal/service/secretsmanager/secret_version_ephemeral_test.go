@@ -46,6 +46,39 @@ func TestAccSecretsManagerSecretVersionEphemeral_basic(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersionEphemeral_secretMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralResourceConfig_secretMap(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_map"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"username": knownvalue.StringExact("admin"),
+						"password": knownvalue.StringExact("s3cr3t"),
+						"port":     knownvalue.StringExact("5432"),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("version_stages"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("AWSCURRENT"),
+					})),
+				},
+			},
+		},
+	})
+}
+
 func testAccSecretVersionEphemeralResourceConfig_basic(rName, secretString string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
@@ -65,3 +98,27 @@ ephemeral "aws_secretsmanager_secret_version" "test" {
 }
 `, rName, secretString))
 }
+
+func testAccSecretVersionEphemeralResourceConfig_secretMap(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    username = "admin"
+    password = "s3cr3t"
+    port     = 5432
+  })
+}
+
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret_version.test.secret_id
+  version_stage = "AWSCURRENT"
+}
+`, rName))
+}
@@ -21,6 +21,11 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_secretsmanager_random_password",
 			Name:     "Random Password",
 		},
+		{
+			Factory:  newEphemeralSecretValues,
+			TypeName: "aws_secretsmanager_secret_values",
+			Name:     "Secret Values",
+		},
 		{
 			Factory:  newEphemeralSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
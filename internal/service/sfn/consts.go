@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+func queryLanguage_Values() []string {
+	return []string{
+		queryLanguageJSONata,
+		queryLanguageJSONPath,
+	}
+}
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+const (
+	processorModeDistributed = "DISTRIBUTED"
+	processorModeInline      = "INLINE"
+)
+
+func processorMode_Values() []string {
+	return []string{
+		processorModeDistributed,
+		processorModeInline,
+	}
+}
+
+const (
+	jitterStrategyFull = "FULL"
+	jitterStrategyNone = "NONE"
+)
+
+func jitterStrategy_Values() []string {
+	return []string{
+		jitterStrategyFull,
+		jitterStrategyNone,
+	}
+}
@@ -39,6 +39,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_sfn_state_machine",
 			Name:     "State Machine",
 		},
+		{
+			Factory:  dataSourceStateMachineDefinition,
+			TypeName: "aws_sfn_state_machine_definition",
+			Name:     "State Machine Definition",
+		},
 		{
 			Factory:  dataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
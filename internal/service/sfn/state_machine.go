@@ -43,7 +43,10 @@ func resourceStateMachine() *schema.Resource {
 		DeleteWithoutTimeout: resourceStateMachineDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("validate_definition", true)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -187,6 +190,11 @@ func resourceStateMachine() *schema.Resource {
 				Default:          awstypes.StateMachineTypeStandard,
 				ValidateDiagFunc: enum.Validate[awstypes.StateMachineType](),
 			},
+			"validate_definition": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"version_description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -321,7 +329,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "validate_definition") {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
 		publish := d.Get("publish").(bool)
 		input := &sfn.UpdateStateMachineInput{
@@ -553,6 +561,9 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 		if attr.Computed && !attr.Optional {
 			continue
 		}
+		if k == "validate_definition" {
+			continue
+		}
 
 		if d.HasChange(k) {
 			return true
@@ -564,6 +575,10 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
+	if !d.Get("validate_definition").(bool) {
+		return nil
+	}
+
 	if d.HasChange("definition") {
 		definition := d.Get("definition").(string)
 		if definition == "" {
@@ -581,10 +596,16 @@ func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff,
 			return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
 		}
 
+		for _, v := range output.Diagnostics {
+			if v.Severity == awstypes.ValidateStateMachineDefinitionSeverityWarning {
+				log.Printf("[WARN] Step Functions State Machine definition: %s", stateMachineDefinitionDiagnosticError(v))
+			}
+		}
+
 		if result := output.Result; result != awstypes.ValidateStateMachineDefinitionResultCodeOk {
-			errs := tfslices.ApplyToAll(output.Diagnostics, func(v awstypes.ValidateStateMachineDefinitionDiagnostic) error {
-				return fmt.Errorf("%s (%s): %s", v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
-			})
+			errs := tfslices.ApplyToAll(tfslices.Filter(output.Diagnostics, func(v awstypes.ValidateStateMachineDefinitionDiagnostic) bool {
+				return v.Severity != awstypes.ValidateStateMachineDefinitionSeverityWarning
+			}), stateMachineDefinitionDiagnosticError)
 
 			return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(errs...))
 		}
@@ -592,3 +613,14 @@ func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff,
 
 	return nil
 }
+
+// stateMachineDefinitionDiagnosticError returns an error describing the diagnostic,
+// prefixed with the JSON path of the diagnostic's location within the definition if known, e.g. "definition: /States/FirstState/Resource".
+func stateMachineDefinitionDiagnosticError(v awstypes.ValidateStateMachineDefinitionDiagnostic) error {
+	prefix := "definition"
+	if location := aws.ToString(v.Location); location != "" {
+		prefix = fmt.Sprintf("%s: %s", prefix, location)
+	}
+
+	return fmt.Errorf("%s: %s (%s): %s", prefix, v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
+}
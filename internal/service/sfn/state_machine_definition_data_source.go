@@ -0,0 +1,777 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition", name="State Machine Definition")
+func dataSourceStateMachineDefinition() *schema.Resource {
+	jsonSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(queryLanguage_Values(), false),
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": jsonSchema(),
+						"assign":    jsonSchema(),
+						"branch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+						"catch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assign": jsonSchema(),
+									"error_equals": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"output": jsonSchema(),
+									"result_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"choice": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"assign": jsonSchema(),
+									names.AttrCondition: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"output":       jsonSchema(),
+									names.AttrRule: jsonSchema(),
+								},
+							},
+						},
+						names.AttrComment: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"execution_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.StateMachineType](),
+						},
+						"heartbeat_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"input_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item_processor": jsonSchema(),
+						"item_selector":  jsonSchema(),
+						"items":          jsonSchema(),
+						"items_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 80),
+						},
+						"next": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output": jsonSchema(),
+						"output_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrParameters: jsonSchema(),
+						"processor_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(processorMode_Values(), false),
+						},
+						"query_language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(queryLanguage_Values(), false),
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result": jsonSchema(),
+						"result_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result_selector": jsonSchema(),
+						"retry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"error_equals": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"jitter_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(jitterStrategy_Values(), false),
+									},
+									"max_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"max_delay_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stateType_Values(), false),
+						},
+					},
+				},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definition := &stateMachineDefinition{
+		Comment:        d.Get(names.AttrComment).(string),
+		QueryLanguage:  d.Get("query_language").(string),
+		StartAt:        d.Get("start_at").(string),
+		TimeoutSeconds: d.Get("timeout_seconds").(int),
+		Version:        d.Get(names.AttrVersion).(string),
+	}
+
+	// A Wait state's seconds can be 0, so whether it's set is determined from the configuration
+	// and it's removed from the states that don't set it.
+	tfList := d.Get(names.AttrState).([]interface{})
+	if v := d.GetRawConfig().GetAttr(names.AttrState); v.IsKnown() && !v.IsNull() {
+		for i, raw := range v.AsValueSlice() {
+			if tfMap, ok := tfList[i].(map[string]interface{}); ok && raw.GetAttr("seconds").IsNull() {
+				delete(tfMap, "seconds")
+			}
+		}
+	}
+
+	states, err := expandStateMachineStates(tfList, definition.queryLanguage())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: %s", err)
+	}
+
+	definition.States = states
+
+	if _, ok := definition.States[definition.StartAt]; !ok {
+		return sdkdiag.AppendErrorf(diags, "building Step Functions State Machine definition: start_at (%s) is not a state", definition.StartAt)
+	}
+
+	jsonBytes, err := json.MarshalIndent(definition, "", "  ")
+
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing Step Functions State Machine definition: formatting JSON: %s", err)
+	}
+
+	jsonString := string(jsonBytes)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+type stateMachineDefinition struct {
+	Comment        string                        `json:"Comment,omitempty"`
+	QueryLanguage  string                        `json:"QueryLanguage,omitempty"`
+	StartAt        string                        `json:"StartAt"`
+	States         map[string]*stateMachineState `json:"States"`
+	TimeoutSeconds int                           `json:"TimeoutSeconds,omitempty"`
+	Version        string                        `json:"Version,omitempty"`
+}
+
+// queryLanguage returns the definition's query language, which defaults to JSONPath.
+func (d *stateMachineDefinition) queryLanguage() string {
+	if d.QueryLanguage == "" {
+		return queryLanguageJSONPath
+	}
+
+	return d.QueryLanguage
+}
+
+type stateMachineState struct {
+	Type             string                 `json:"Type"`
+	Comment          string                 `json:"Comment,omitempty"`
+	QueryLanguage    string                 `json:"QueryLanguage,omitempty"`
+	Resource         string                 `json:"Resource,omitempty"`
+	InputPath        string                 `json:"InputPath,omitempty"`
+	Arguments        json.RawMessage        `json:"Arguments,omitempty"`
+	Parameters       json.RawMessage        `json:"Parameters,omitempty"`
+	Items            json.RawMessage        `json:"Items,omitempty"`
+	ItemsPath        string                 `json:"ItemsPath,omitempty"`
+	ItemSelector     json.RawMessage        `json:"ItemSelector,omitempty"`
+	ItemProcessor    map[string]any         `json:"ItemProcessor,omitempty"`
+	MaxConcurrency   int                    `json:"MaxConcurrency,omitempty"`
+	Branches         []map[string]any       `json:"Branches,omitempty"`
+	Choices          []map[string]any       `json:"Choices,omitempty"`
+	Default          string                 `json:"Default,omitempty"`
+	Seconds          *int                   `json:"Seconds,omitempty"`
+	Timestamp        string                 `json:"Timestamp,omitempty"`
+	TimeoutSeconds   int                    `json:"TimeoutSeconds,omitempty"`
+	HeartbeatSeconds int                    `json:"HeartbeatSeconds,omitempty"`
+	Result           json.RawMessage        `json:"Result,omitempty"`
+	ResultSelector   json.RawMessage        `json:"ResultSelector,omitempty"`
+	ResultPath       string                 `json:"ResultPath,omitempty"`
+	OutputPath       string                 `json:"OutputPath,omitempty"`
+	Output           json.RawMessage        `json:"Output,omitempty"`
+	Assign           json.RawMessage        `json:"Assign,omitempty"`
+	Retry            []*stateMachineRetrier `json:"Retry,omitempty"`
+	Catch            []*stateMachineCatcher `json:"Catch,omitempty"`
+	Error            string                 `json:"Error,omitempty"`
+	Cause            string                 `json:"Cause,omitempty"`
+	Next             string                 `json:"Next,omitempty"`
+	End              bool                   `json:"End,omitempty"`
+}
+
+type stateMachineRetrier struct {
+	ErrorEquals     []string `json:"ErrorEquals"`
+	IntervalSeconds int      `json:"IntervalSeconds,omitempty"`
+	MaxAttempts     int      `json:"MaxAttempts"`
+	BackoffRate     float64  `json:"BackoffRate,omitempty"`
+	MaxDelaySeconds int      `json:"MaxDelaySeconds,omitempty"`
+	JitterStrategy  string   `json:"JitterStrategy,omitempty"`
+}
+
+type stateMachineCatcher struct {
+	ErrorEquals []string        `json:"ErrorEquals"`
+	Next        string          `json:"Next"`
+	ResultPath  string          `json:"ResultPath,omitempty"`
+	Output      json.RawMessage `json:"Output,omitempty"`
+	Assign      json.RawMessage `json:"Assign,omitempty"`
+}
+
+var (
+	// stateMachineStateTypeArguments are the arguments, other than name, type, comment and query_language, valid for each state type.
+	stateMachineStateTypeArguments = map[string][]string{
+		stateTypeChoice:   {"assign", "choice", "default", "input_path", "output", "output_path"},
+		stateTypeFail:     {"cause", "error"},
+		stateTypeMap:      {"assign", "catch", "end", "execution_type", "input_path", "item_processor", "item_selector", "items", "items_path", "max_concurrency", "next", "output", "output_path", "processor_mode", "result_path", "result_selector", "retry"},
+		stateTypeParallel: {"arguments", "assign", "branch", "catch", "end", "input_path", "next", "output", "output_path", names.AttrParameters, "result_path", "result_selector", "retry"},
+		stateTypePass:     {"assign", "end", "input_path", "next", "output", "output_path", names.AttrParameters, "result", "result_path"},
+		stateTypeSucceed:  {"input_path", "output", "output_path"},
+		stateTypeTask:     {"arguments", "assign", "catch", "end", "heartbeat_seconds", "input_path", "next", "output", "output_path", names.AttrParameters, "resource", "result_path", "result_selector", "retry", "timeout_seconds"},
+		stateTypeWait:     {"assign", "end", "input_path", "next", "output", "output_path", "seconds", "timestamp"},
+	}
+
+	// stateMachineQueryLanguageArguments are the state arguments valid only with each query language.
+	stateMachineQueryLanguageArguments = map[string][]string{
+		queryLanguageJSONata:  {"arguments", "items", "output"},
+		queryLanguageJSONPath: {"input_path", "items_path", "output_path", names.AttrParameters, "result", "result_path", "result_selector"},
+	}
+)
+
+// expandStateMachineStates returns the states keyed by name, validating each state's arguments against its type and query language
+// and that every transition is to a state in the list.
+func expandStateMachineStates(tfList []interface{}, queryLanguage string) (map[string]*stateMachineState, error) {
+	apiObjects := make(map[string]*stateMachineState, len(tfList))
+	var errs []error
+	var transitions []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+
+		if _, ok := apiObjects[name]; ok {
+			errs = append(errs, fmt.Errorf("state (%s): duplicate name", name))
+			continue
+		}
+
+		apiObject, next, err := expandStateMachineState(tfMap, queryLanguage)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("state (%s): %w", name, err))
+			continue
+		}
+
+		apiObjects[name] = apiObject
+		transitions = append(transitions, next...)
+	}
+
+	for _, v := range transitions {
+		if _, ok := apiObjects[v]; !ok {
+			errs = append(errs, fmt.Errorf("transition to unknown state (%s)", v))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return apiObjects, nil
+}
+
+// expandStateMachineState returns the state and the names of the states it transitions to.
+func expandStateMachineState(tfMap map[string]interface{}, definitionQueryLanguage string) (*stateMachineState, []string, error) {
+	typ := tfMap[names.AttrType].(string)
+	apiObject := &stateMachineState{
+		Comment:       tfMap[names.AttrComment].(string),
+		QueryLanguage: tfMap["query_language"].(string),
+		Type:          typ,
+	}
+
+	queryLanguage := definitionQueryLanguage
+	if apiObject.QueryLanguage != "" {
+		queryLanguage = apiObject.QueryLanguage
+	}
+
+	var errs []error
+
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		// seconds is only present if it's set.
+		if k != "seconds" && !isStateMachineStateArgumentSet(tfMap[k]) {
+			continue
+		}
+
+		switch k {
+		case names.AttrComment, names.AttrName, "query_language", names.AttrType:
+			continue
+		}
+
+		if !slices.Contains(stateMachineStateTypeArguments[typ], k) {
+			errs = append(errs, fmt.Errorf("%s is not valid for %s states", k, typ))
+		}
+
+		for _, ql := range queryLanguage_Values() {
+			if ql != queryLanguage && slices.Contains(stateMachineQueryLanguageArguments[ql], k) {
+				errs = append(errs, fmt.Errorf("%s is not valid with the %s query language", k, queryLanguage))
+			}
+		}
+	}
+
+	var next []string
+
+	switch typ {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+	default:
+		apiObject.End = tfMap["end"].(bool)
+		apiObject.Next = tfMap["next"].(string)
+
+		if apiObject.End == (apiObject.Next != "") {
+			errs = append(errs, fmt.Errorf("exactly one of end or next must be set for %s states", typ))
+		}
+
+		if apiObject.Next != "" {
+			next = append(next, apiObject.Next)
+		}
+	}
+
+	switch typ {
+	case stateTypeChoice:
+		if len(tfMap["choice"].([]interface{})) == 0 {
+			errs = append(errs, errors.New("at least one choice is required for Choice states"))
+		}
+	case stateTypeMap:
+		if tfMap["item_processor"].(string) == "" {
+			errs = append(errs, errors.New("item_processor is required for Map states"))
+		}
+	case stateTypeParallel:
+		if len(tfMap["branch"].([]interface{})) == 0 {
+			errs = append(errs, errors.New("at least one branch is required for Parallel states"))
+		}
+	case stateTypeTask:
+		if tfMap["resource"].(string) == "" {
+			errs = append(errs, errors.New("resource is required for Task states"))
+		}
+	case stateTypeWait:
+		if _, ok := tfMap["seconds"]; ok == (tfMap["timestamp"].(string) != "") {
+			errs = append(errs, errors.New("exactly one of seconds or timestamp must be set for Wait states"))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+
+	apiObject.Arguments = stateMachineRawJSON(tfMap["arguments"].(string))
+	apiObject.Assign = stateMachineRawJSON(tfMap["assign"].(string))
+	apiObject.Cause = tfMap["cause"].(string)
+	apiObject.Default = tfMap["default"].(string)
+	apiObject.Error = tfMap["error"].(string)
+	apiObject.HeartbeatSeconds = tfMap["heartbeat_seconds"].(int)
+	apiObject.InputPath = tfMap["input_path"].(string)
+	apiObject.ItemSelector = stateMachineRawJSON(tfMap["item_selector"].(string))
+	apiObject.Items = stateMachineRawJSON(tfMap["items"].(string))
+	apiObject.ItemsPath = tfMap["items_path"].(string)
+	apiObject.MaxConcurrency = tfMap["max_concurrency"].(int)
+	apiObject.Output = stateMachineRawJSON(tfMap["output"].(string))
+	apiObject.OutputPath = tfMap["output_path"].(string)
+	apiObject.Parameters = stateMachineRawJSON(tfMap[names.AttrParameters].(string))
+	apiObject.Resource = tfMap["resource"].(string)
+	apiObject.Result = stateMachineRawJSON(tfMap["result"].(string))
+	apiObject.ResultPath = tfMap["result_path"].(string)
+	apiObject.ResultSelector = stateMachineRawJSON(tfMap["result_selector"].(string))
+	if v, ok := tfMap["seconds"].(int); ok {
+		apiObject.Seconds = aws.Int(v)
+	}
+	apiObject.TimeoutSeconds = tfMap["timeout_seconds"].(int)
+	apiObject.Timestamp = tfMap["timestamp"].(string)
+
+	if apiObject.Default != "" {
+		next = append(next, apiObject.Default)
+	}
+
+	for _, v := range tfMap["branch"].([]interface{}) {
+		branch, err := expandStateMachineNestedDefinition(v.(string), queryLanguage)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("branch: %w", err)
+		}
+
+		apiObject.Branches = append(apiObject.Branches, branch)
+	}
+
+	for _, tfMapRaw := range tfMap["catch"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		catcher := &stateMachineCatcher{
+			Assign:      stateMachineRawJSON(tfMap["assign"].(string)),
+			ErrorEquals: flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			Next:        tfMap["next"].(string),
+			Output:      stateMachineRawJSON(tfMap["output"].(string)),
+			ResultPath:  tfMap["result_path"].(string),
+		}
+
+		if catcher.Output != nil && queryLanguage != queryLanguageJSONata {
+			return nil, nil, fmt.Errorf("catch: output is not valid with the %s query language", queryLanguage)
+		}
+
+		if catcher.ResultPath != "" && queryLanguage != queryLanguageJSONPath {
+			return nil, nil, fmt.Errorf("catch: result_path is not valid with the %s query language", queryLanguage)
+		}
+
+		apiObject.Catch = append(apiObject.Catch, catcher)
+		next = append(next, catcher.Next)
+	}
+
+	for _, tfMapRaw := range tfMap["choice"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		choice, err := expandStateMachineChoice(tfMap, queryLanguage)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("choice: %w", err)
+		}
+
+		apiObject.Choices = append(apiObject.Choices, choice)
+		next = append(next, tfMap["next"].(string))
+	}
+
+	if v := tfMap["item_processor"].(string); v != "" {
+		itemProcessor, err := expandStateMachineNestedDefinition(v, queryLanguage)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("item_processor: %w", err)
+		}
+
+		processorConfig := map[string]any{}
+		if v := tfMap["execution_type"].(string); v != "" {
+			processorConfig["ExecutionType"] = v
+		}
+		if v := tfMap["processor_mode"].(string); v != "" {
+			processorConfig["Mode"] = v
+		}
+		if len(processorConfig) > 0 {
+			itemProcessor["ProcessorConfig"] = processorConfig
+		}
+
+		apiObject.ItemProcessor = itemProcessor
+	}
+
+	for _, tfMapRaw := range tfMap["retry"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Retry = append(apiObject.Retry, &stateMachineRetrier{
+			BackoffRate:     tfMap["backoff_rate"].(float64),
+			ErrorEquals:     flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+			IntervalSeconds: tfMap["interval_seconds"].(int),
+			JitterStrategy:  tfMap["jitter_strategy"].(string),
+			MaxAttempts:     tfMap["max_attempts"].(int),
+			MaxDelaySeconds: tfMap["max_delay_seconds"].(int),
+		})
+	}
+
+	return apiObject, next, nil
+}
+
+// expandStateMachineChoice returns a Choice state's choice rule.
+// JSONata choices are expressed with a condition and JSONPath choices with a rule, a JSON choice rule object without Next.
+func expandStateMachineChoice(tfMap map[string]interface{}, queryLanguage string) (map[string]any, error) {
+	condition, rule := tfMap[names.AttrCondition].(string), tfMap[names.AttrRule].(string)
+
+	switch queryLanguage {
+	case queryLanguageJSONata:
+		if condition == "" || rule != "" {
+			return nil, fmt.Errorf("condition, and not rule, is required with the %s query language", queryLanguage)
+		}
+	default:
+		if rule == "" || condition != "" {
+			return nil, fmt.Errorf("rule, and not condition, is required with the %s query language", queryLanguage)
+		}
+	}
+
+	apiObject := map[string]any{}
+
+	if rule != "" {
+		if err := stateMachineDecodeJSON(rule, &apiObject); err != nil {
+			return nil, fmt.Errorf("rule: %w", err)
+		}
+	}
+
+	if condition != "" {
+		apiObject["Condition"] = condition
+	}
+
+	if v := stateMachineRawJSON(tfMap["assign"].(string)); v != nil {
+		apiObject["Assign"] = v
+	}
+
+	if v := stateMachineRawJSON(tfMap["output"].(string)); v != nil {
+		if queryLanguage != queryLanguageJSONata {
+			return nil, fmt.Errorf("output is not valid with the %s query language", queryLanguage)
+		}
+
+		apiObject["Output"] = v
+	}
+
+	apiObject["Next"] = tfMap["next"].(string)
+
+	return apiObject, nil
+}
+
+// expandStateMachineNestedDefinition returns the Parallel state branch or Map state item processor for a state machine definition,
+// such as the json attribute of another aws_sfn_state_machine_definition data source.
+// Only the definition's Comment, StartAt and States are kept. If the definition's query language differs from the enclosing
+// state's, it is applied to each of the definition's states that does not specify its own.
+func expandStateMachineNestedDefinition(s string, queryLanguage string) (map[string]any, error) {
+	var definition map[string]any
+
+	if err := stateMachineDecodeJSON(s, &definition); err != nil {
+		return nil, err
+	}
+
+	states, ok := definition["States"].(map[string]any)
+	if !ok {
+		return nil, errors.New("definition has no States")
+	}
+
+	if _, ok := definition["StartAt"].(string); !ok {
+		return nil, errors.New("definition has no StartAt")
+	}
+
+	v, ok := definition["QueryLanguage"].(string)
+	if !ok {
+		v = queryLanguageJSONPath
+	}
+	if v != queryLanguage {
+		for _, state := range states {
+			if state, ok := state.(map[string]any); ok {
+				if _, ok := state["QueryLanguage"]; !ok {
+					state["QueryLanguage"] = v
+				}
+			}
+		}
+	}
+
+	apiObject := map[string]any{
+		"StartAt": definition["StartAt"],
+		"States":  states,
+	}
+
+	if v, ok := definition["Comment"]; ok {
+		apiObject["Comment"] = v
+	}
+
+	return apiObject, nil
+}
+
+func isStateMachineStateArgumentSet(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case int:
+		return v != 0
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	}
+
+	return false
+}
+
+func stateMachineDecodeJSON(s string, v any) error {
+	decoder := json.NewDecoder(bytes.NewBufferString(s))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+func stateMachineRawJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+
+	return json.RawMessage(s)
+}
@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDataSourceConfig_basic_expectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_nested(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_nested,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDataSourceConfig_nested_expectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_stateMachine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_stateMachine(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "validate_definition", acctest.CtTrue),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "definition", testAccStateMachineDefinitionDataSourceConfig_nested_expectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_unknownTransition,
+				ExpectError: regexache.MustCompile(`transition to unknown state \(Missing\)`),
+			},
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_wrongQueryLanguage,
+				ExpectError: regexache.MustCompile(`state \(Hello\): arguments is not valid with the JSONPath query language`),
+			},
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_nextAndEnd,
+				ExpectError: regexache.MustCompile(`state \(Hello\): exactly one of end or next must be set for Pass states`),
+			},
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_secondsAndTimestamp,
+				ExpectError: regexache.MustCompile(`state \(Wait\): exactly one of seconds or timestamp must be set for Wait states`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition" "test" {
+  comment  = "basic"
+  start_at = "Invoke"
+
+  state {
+    name     = "Invoke"
+    type     = "Task"
+    resource = "arn:aws:states:::lambda:invoke"
+
+    parameters = jsonencode({
+      "FunctionName" = "example"
+      "Payload.$"    = "$"
+    })
+
+    result_path = "$.result"
+    next        = "Check"
+
+    retry {
+      error_equals     = ["States.TaskFailed"]
+      interval_seconds = 2
+      backoff_rate     = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+      result_path  = "$.error"
+    }
+  }
+
+  state {
+    name = "Check"
+    type = "Choice"
+
+    choice {
+      rule = jsonencode({
+        Variable      = "$.result.StatusCode"
+        NumericEquals = 200
+      })
+      next = "Done"
+    }
+
+    default = "Failed"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "InvokeFailed"
+    cause = "The function could not be invoked"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_basic_expectedJSON = `{
+  "Comment": "basic",
+  "StartAt": "Invoke",
+  "States": {
+    "Invoke": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "example",
+        "Payload.$": "$"
+      },
+      "ResultPath": "$.result",
+      "Retry": [
+        {
+          "ErrorEquals": ["States.TaskFailed"],
+          "IntervalSeconds": 2,
+          "MaxAttempts": 3,
+          "BackoffRate": 2
+        }
+      ],
+      "Catch": [
+        {
+          "ErrorEquals": ["States.ALL"],
+          "Next": "Failed",
+          "ResultPath": "$.error"
+        }
+      ],
+      "Next": "Check"
+    },
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "Variable": "$.result.StatusCode",
+          "NumericEquals": 200,
+          "Next": "Done"
+        }
+      ],
+      "Default": "Failed"
+    },
+    "Done": {
+      "Type": "Succeed"
+    },
+    "Failed": {
+      "Type": "Fail",
+      "Error": "InvokeFailed",
+      "Cause": "The function could not be invoked"
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDataSourceConfig_nested = `
+data "aws_sfn_state_machine_definition" "branch" {
+  query_language = "JSONata"
+  start_at       = "Wait"
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 0
+    end     = true
+  }
+}
+
+data "aws_sfn_state_machine_definition" "item" {
+  query_language = "JSONata"
+  start_at       = "Item"
+
+  state {
+    name   = "Item"
+    type   = "Pass"
+    output = jsonencode({ processed = true })
+    end    = true
+  }
+}
+
+data "aws_sfn_state_machine_definition" "test" {
+  query_language = "JSONata"
+  start_at       = "Fanout"
+
+  state {
+    name   = "Fanout"
+    type   = "Parallel"
+    branch = [data.aws_sfn_state_machine_definition.branch.json]
+    next   = "Each"
+  }
+
+  state {
+    name            = "Each"
+    type            = "Map"
+    items           = jsonencode("{% $states.input.items %}")
+    item_processor  = data.aws_sfn_state_machine_definition.item.json
+    processor_mode  = "INLINE"
+    max_concurrency = 2
+    output          = jsonencode({ count = "{% $count($states.result) %}" })
+    end             = true
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_nested_expectedJSON = `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Fanout",
+  "States": {
+    "Fanout": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Wait",
+          "States": {
+            "Wait": {
+              "Type": "Wait",
+              "Seconds": 0,
+              "End": true
+            }
+          }
+        }
+      ],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "Items": "{% $states.input.items %}",
+      "ItemProcessor": {
+        "ProcessorConfig": {
+          "Mode": "INLINE"
+        },
+        "StartAt": "Item",
+        "States": {
+          "Item": {
+            "Type": "Pass",
+            "Output": {
+              "processed": true
+            },
+            "End": true
+          }
+        }
+      },
+      "MaxConcurrency": 2,
+      "Output": {
+        "count": "{% $count($states.result) %}"
+      },
+      "End": true
+    }
+  }
+}`
+
+func testAccStateMachineDefinitionDataSourceConfig_stateMachine(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineDefinitionDataSourceConfig_nested, fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["states.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name       = %[1]q
+  role_arn   = aws_iam_role.test.arn
+  definition = data.aws_sfn_state_machine_definition.test.json
+}
+`, rName))
+}
+
+const testAccStateMachineDefinitionDataSourceConfig_unknownTransition = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Hello"
+
+  state {
+    name = "Hello"
+    type = "Pass"
+    next = "Missing"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_wrongQueryLanguage = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Hello"
+
+  state {
+    name      = "Hello"
+    type      = "Task"
+    resource  = "arn:aws:states:::lambda:invoke"
+    arguments = jsonencode({ FunctionName = "example" })
+    end       = true
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_nextAndEnd = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Hello"
+
+  state {
+    name = "Hello"
+    type = "Pass"
+    next = "Hello"
+    end  = true
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_secondsAndTimestamp = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Wait"
+
+  state {
+    name      = "Wait"
+    type      = "Wait"
+    seconds   = 0
+    timestamp = "2016-03-14T01:59:00Z"
+    end       = true
+  }
+}
+`
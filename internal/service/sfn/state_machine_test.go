@@ -605,8 +605,26 @@ func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccStateMachineConfig_invalidDefinition(rName),
-				ExpectError: regexache.MustCompile("invalid Step Functions State Machine definition: .+"),
+				Config:      testAccStateMachineConfig_invalidDefinition(rName, true),
+				ExpectError: regexache.MustCompile("invalid Step Functions State Machine definition: definition: .+"),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_definitionValidationDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_invalidDefinition(rName, false),
+				ExpectError: regexache.MustCompile("creating Step Functions State Machine .+InvalidDefinition"),
 			},
 		},
 	})
@@ -1247,12 +1265,14 @@ EOF
 `, rName, rType))
 }
 
-func testAccStateMachineConfig_invalidDefinition(rName string) string {
+func testAccStateMachineConfig_invalidDefinition(rName string, validateDefinition bool) string {
 	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
 resource "aws_sfn_state_machine" "test" {
   name     = %[1]q
   role_arn = aws_iam_role.for_sfn.arn
 
+  validate_definition = %[2]t
+
   definition = <<EOF
 {
   "Comment": "A wrongly made stepfunction definition (replaced 'States' by 'Status')",
@@ -1277,5 +1297,5 @@ resource "aws_sfn_state_machine" "test" {
 }
 EOF
 }
-`, rName))
+`, rName, validateDefinition))
 }
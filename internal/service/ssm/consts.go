@@ -10,3 +10,14 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// Maximum number of concurrent PutParameter requests made by the aws_ssm_parameters resource.
+	parametersMaxConcurrency = 5
+
+	// Maximum number of names in a DeleteParameters request.
+	parametersDeleteBatchSize = 10
+
+	// KMS key used to encrypt SecureString parameters when no key is specified.
+	parameterDefaultKeyID = "alias/aws/ssm"
+)
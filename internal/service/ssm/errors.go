@@ -4,5 +4,6 @@
 package ssm
 
 const (
+	errCodeThrottlingException = "ThrottlingException"
 	errCodeValidationException = "ValidationException"
 )
@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssm_parameters", name="Parameters")
+func resourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrParameter: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexache.MustCompile(`^[^/].*[^/]$|^[^/]$`), "must not begin or end with a /"),
+							),
+						},
+						"tier": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(awstypes.ParameterTierStandard),
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterTier](),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(awstypes.ParameterTypeString),
+							ValidateDiagFunc: enum.Validate[awstypes.ParameterType](),
+						},
+						names.AttrValue: {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			names.AttrPath: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 1024),
+					validation.StringMatch(regexache.MustCompile(`^/.*[^/]$`), "must begin with a / and must not end with a /"),
+				),
+			},
+		},
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	path := d.Get(names.AttrPath).(string)
+	parameters := expandParameterEntries(d.Get(names.AttrParameter).(*schema.Set).List())
+
+	var inputs []*ssm.PutParameterInput
+	for _, name := range slices.Sorted(maps.Keys(parameters)) {
+		inputs = append(inputs, parameters[name].putParameterInput(path, false))
+	}
+
+	if err := putParameters(ctx, conn, inputs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+	}
+
+	d.SetId(path)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(d.Id()),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+
+	output, err := findParametersByPath(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	// GetParametersByPath doesn't return a parameter's KMS key or tier.
+	metadata, err := findParametersMetadataByPath(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s) metadata: %s", d.Id(), err)
+	}
+
+	prefix := d.Id() + "/"
+	found := make(map[string]awstypes.Parameter, len(output))
+	for _, v := range output {
+		found[strings.TrimPrefix(aws.ToString(v.Name), prefix)] = v
+	}
+	foundMetadata := make(map[string]awstypes.ParameterMetadata, len(metadata))
+	for _, v := range metadata {
+		foundMetadata[strings.TrimPrefix(aws.ToString(v.Name), prefix)] = v
+	}
+
+	// On import every parameter under the path is managed, otherwise parameters not in state are ignored.
+	parameters := expandParameterEntries(d.Get(names.AttrParameter).(*schema.Set).List())
+	if len(parameters) == 0 {
+		for name := range found {
+			parameters[name] = parameterEntry{
+				name: name,
+			}
+		}
+	}
+
+	var tfList []interface{}
+	for _, name := range slices.Sorted(maps.Keys(parameters)) {
+		v, ok := found[name]
+
+		if !ok {
+			continue
+		}
+
+		entry := parameters[name]
+		entry.typ = v.Type
+		entry.value = aws.ToString(v.Value)
+
+		if v, ok := foundMetadata[name]; ok {
+			// The tier of an Intelligent-Tiering parameter is reported as the tier chosen for it.
+			if entry.tier != awstypes.ParameterTierIntelligentTiering {
+				entry.tier = v.Tier
+			}
+
+			// The default key is only tracked if it's configured.
+			if keyID := aws.ToString(v.KeyId); entry.keyID != "" || keyID != parameterDefaultKeyID {
+				entry.keyID = keyID
+			}
+		}
+
+		tfList = append(tfList, entry.flatten())
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] SSM Parameters %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set(names.AttrParameter, tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+	}
+	d.Set(names.AttrPath, d.Id())
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	o, n := d.GetChange(names.AttrParameter)
+	oldEntries, newEntries := expandParameterEntries(o.(*schema.Set).List()), expandParameterEntries(n.(*schema.Set).List())
+
+	var inputs []*ssm.PutParameterInput
+	for _, name := range slices.Sorted(maps.Keys(newEntries)) {
+		entry := newEntries[name]
+		v, ok := oldEntries[name]
+
+		if ok && v == entry {
+			continue
+		}
+
+		inputs = append(inputs, entry.putParameterInput(d.Id(), ok))
+	}
+
+	if err := putParameters(ctx, conn, inputs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	var toDelete []string
+	for name := range oldEntries {
+		if _, ok := newEntries[name]; !ok {
+			toDelete = append(toDelete, parameterFullName(d.Id(), name))
+		}
+	}
+	slices.Sort(toDelete)
+
+	if err := deleteParameters(ctx, conn, toDelete, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	var toDelete []string
+	for name := range expandParameterEntries(d.Get(names.AttrParameter).(*schema.Set).List()) {
+		toDelete = append(toDelete, parameterFullName(d.Id(), name))
+	}
+	slices.Sort(toDelete)
+
+	log.Printf("[DEBUG] Deleting SSM Parameters: %s", d.Id())
+	if err := deleteParameters(ctx, conn, toDelete, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// putParameters makes the PutParameter requests with at most parametersMaxConcurrency in flight,
+// retrying requests that are throttled.
+func putParameters(ctx context.Context, conn *ssm.Client, inputs []*ssm.PutParameterInput, timeout time.Duration) error {
+	var g multierror.Group
+	sem := make(chan struct{}, parametersMaxConcurrency)
+
+	for _, input := range inputs {
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			_, err := tfresource.RetryWhen(ctx, timeout,
+				func() (interface{}, error) {
+					return conn.PutParameter(ctx, input)
+				},
+				isParameterThrottlingError,
+			)
+
+			if err != nil {
+				return fmt.Errorf("putting SSM Parameter (%s): %w", aws.ToString(input.Name), err)
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// deleteParameters deletes the named parameters in batches, ignoring parameters that do not exist.
+func deleteParameters(ctx context.Context, conn *ssm.Client, parameterNames []string, timeout time.Duration) error {
+	for chunk := range slices.Chunk(parameterNames, parametersDeleteBatchSize) {
+		input := &ssm.DeleteParametersInput{
+			Names: chunk,
+		}
+
+		_, err := tfresource.RetryWhen(ctx, timeout,
+			func() (interface{}, error) {
+				return conn.DeleteParameters(ctx, input)
+			},
+			isParameterThrottlingError,
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isParameterThrottlingError(err error) (bool, error) {
+	if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) || errs.IsA[*awstypes.TooManyUpdates](err) {
+		return true, err
+	}
+
+	return false, err
+}
+
+// findParametersMetadataByPath returns the metadata of every parameter under the path.
+func findParametersMetadataByPath(ctx context.Context, conn *ssm.Client, path string) ([]awstypes.ParameterMetadata, error) {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []awstypes.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String("Recursive"),
+				Values: []string{path},
+			},
+		},
+	}
+
+	return findParametersMetadata(ctx, conn, input)
+}
+
+func parameterFullName(path, name string) string {
+	return path + "/" + name
+}
+
+type parameterEntry struct {
+	keyID string
+	name  string
+	tier  awstypes.ParameterTier
+	typ   awstypes.ParameterType
+	value string
+}
+
+// expandParameterEntries returns the parameter entries keyed by name relative to the path.
+func expandParameterEntries(tfList []interface{}) map[string]parameterEntry {
+	apiObjects := make(map[string]parameterEntry, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entry := parameterEntry{
+			keyID: tfMap[names.AttrKeyID].(string),
+			name:  tfMap[names.AttrName].(string),
+			tier:  awstypes.ParameterTier(tfMap["tier"].(string)),
+			typ:   awstypes.ParameterType(tfMap[names.AttrType].(string)),
+			value: tfMap[names.AttrValue].(string),
+		}
+
+		apiObjects[entry.name] = entry
+	}
+
+	return apiObjects
+}
+
+func (e parameterEntry) flatten() map[string]interface{} {
+	return map[string]interface{}{
+		names.AttrKeyID: e.keyID,
+		names.AttrName:  e.name,
+		"tier":          string(e.tier),
+		names.AttrType:  string(e.typ),
+		names.AttrValue: e.value,
+	}
+}
+
+func (e parameterEntry) putParameterInput(path string, overwrite bool) *ssm.PutParameterInput {
+	input := &ssm.PutParameterInput{
+		Name:      aws.String(parameterFullName(path, e.name)),
+		Overwrite: aws.Bool(overwrite),
+		Tier:      e.tier,
+		Type:      e.typ,
+		Value:     aws.String(e.value),
+	}
+
+	if e.keyID != "" && e.typ == awstypes.ParameterTypeSecureString {
+		input.KeyId = aws.String(e.keyID)
+	}
+
+	return input
+}
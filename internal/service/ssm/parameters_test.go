@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPath, "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "db/host",
+						names.AttrType:  "String",
+						"tier":          "Standard",
+						names.AttrValue: "db.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "db/password",
+						names.AttrType:  "SecureString",
+						names.AttrValue: "s3cr3t",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "hosts",
+						names.AttrType:  "StringList",
+						names.AttrValue: "a,b,c",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+				),
+			},
+			{
+				Config: testAccParametersConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "db/host",
+						names.AttrValue: "db2.example.com",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "db/password",
+						names.AttrType:  "SecureString",
+						names.AttrValue: "s3cr3t",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName:  "db/port",
+						names.AttrValue: "5432",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_tier(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_tier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						names.AttrName: "config",
+						"tier":         "Advanced",
+					}),
+				),
+			},
+			{
+				// The tier is read from the API on import.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_many(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_many(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersExists(ctx, resourceName, 50),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "50"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, &ssm.GetParametersByPathInput{
+				Path:      aws.String(rs.Primary.ID),
+				Recursive: aws.Bool(true),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersExists(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, &ssm.GetParametersByPathInput{
+			Path:      aws.String(rs.Primary.ID),
+			Recursive: aws.Bool(true),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), count; got != want {
+			return fmt.Errorf("SSM Parameters %s count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccParametersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "db/host"
+    value = "db.example.com"
+  }
+
+  parameter {
+    name  = "db/password"
+    type  = "SecureString"
+    value = "s3cr3t"
+  }
+
+  parameter {
+    name  = "hosts"
+    type  = "StringList"
+    value = "a,b,c"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "db/host"
+    value = "db2.example.com"
+  }
+
+  parameter {
+    name  = "db/password"
+    type  = "SecureString"
+    value = "s3cr3t"
+  }
+
+  parameter {
+    name  = "db/port"
+    value = "5432"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_tier(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameter {
+    name  = "config"
+    tier  = "Advanced"
+    value = "value"
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_many(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  dynamic "parameter" {
+    for_each = range(%[2]d)
+
+    content {
+      name  = "param-${parameter.value}"
+      value = "value-${parameter.value}"
+    }
+  }
+}
+`, rName, count)
+}
@@ -91,6 +91,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_wafv2_web_acl_logging_configuration",
 			Name:     "Web ACL Logging Configuration",
 		},
+		{
+			Factory:  resourceWebACLRule,
+			TypeName: "aws_wafv2_web_acl_rule",
+			Name:     "Web ACL Rule",
+		},
 	}
 }
 
@@ -89,6 +89,12 @@ func resourceWebACL() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(1, 256),
 				},
+				"ignore_rules": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					Default:       false,
+					ConflictsWith: []string{names.AttrRule, "rule_json"},
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -105,7 +111,7 @@ func resourceWebACL() *schema.Resource {
 				"rule_json": {
 					Type:             schema.TypeString,
 					Optional:         true,
-					ConflictsWith:    []string{names.AttrRule},
+					ConflictsWith:    []string{"ignore_rules", names.AttrRule},
 					ValidateFunc:     validation.StringIsJSON,
 					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 					StateFunc: func(v interface{}) string {
@@ -116,48 +122,9 @@ func resourceWebACL() *schema.Resource {
 				names.AttrRule: {
 					Type:          schema.TypeSet,
 					Optional:      true,
-					ConflictsWith: []string{"rule_json"},
+					ConflictsWith: []string{"ignore_rules", "rule_json"},
 					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrAction: {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"allow":     allowConfigSchema(),
-										"block":     blockConfigSchema(),
-										"captcha":   captchaConfigSchema(),
-										"challenge": challengeConfigSchema(),
-										"count":     countConfigSchema(),
-									},
-								},
-							},
-							"captcha_config": outerCaptchaConfigSchema(),
-							names.AttrName: {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validation.StringLenBetween(1, 128),
-							},
-							"override_action": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"count": emptySchema(),
-										"none":  emptySchema(),
-									},
-								},
-							},
-							names.AttrPriority: {
-								Type:     schema.TypeInt,
-								Required: true,
-							},
-							"rule_label":        ruleLabelsSchema(),
-							"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
-							"visibility_config": visibilityConfigSchema(),
-						},
+						Schema: webACLRuleSchema(),
 					},
 				},
 				names.AttrScope: {
@@ -285,7 +252,10 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("lock_token", output.LockToken)
 	d.Set(names.AttrName, webACL.Name)
 
-	if _, ok := d.GetOk("rule_json"); !ok {
+	if d.Get("ignore_rules").(bool) {
+		// Rules are managed by aws_wafv2_web_acl_rule resources.
+		d.Set(names.AttrRule, nil)
+	} else if _, ok := d.GetOk("rule_json"); !ok {
 		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
 		if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
@@ -315,15 +285,31 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
 		var rules []awstypes.Rule
 
-		rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
-		if sr := findShieldRule(rules); len(sr) == 0 {
+		if d.Get("ignore_rules").(bool) {
+			// Serialize with any aws_wafv2_web_acl_rule resources and send back the current rules unchanged.
+			mutexKey := webACLMutexKey(d.Get(names.AttrARN).(string))
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 			output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
 			}
 
-			rules = append(rules, findShieldRule(output.WebACL.Rules)...)
+			aclLockToken = aws.ToString(output.LockToken)
+			rules = output.WebACL.Rules
+		} else {
+			rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+			if sr := findShieldRule(rules); len(sr) == 0 {
+				output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
+				}
+
+				rules = append(rules, findShieldRule(output.WebACL.Rules)...)
+			}
 		}
 
 		if v, ok := d.GetOk("rule_json"); ok {
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	webACLRuleResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_wafv2_web_acl_rule", name="Web ACL Rule")
+func resourceWebACLRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebACLRuleCreate,
+		ReadWithoutTimeout:   resourceWebACLRuleRead,
+		UpdateWithoutTimeout: resourceWebACLRuleUpdate,
+		DeleteWithoutTimeout: resourceWebACLRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := webACLRuleSchema()
+
+			s[names.AttrName].ForceNew = true
+			s["web_acl_arn"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			}
+
+			return s
+		},
+	}
+}
+
+func resourceWebACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	name := d.Get(names.AttrName).(string)
+	id, err := flex.FlattenResourceId([]string{webACLARN, name}, webACLRuleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule := expandWebACLRule(webACLRuleResourceDataMap(d))
+	if err := modifyWebACLRule(ctx, conn, webACLARN, name, &rule, true, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WAFv2 WebACL Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), webACLRuleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webACLARN, name := parts[0], parts[1]
+	rule, err := findWebACLRuleByTwoPartKey(ctx, conn, webACLARN, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAFv2 WebACL Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	tfMap := flattenWebACLRules([]awstypes.Rule{*rule}).([]map[string]interface{})[0]
+	for k, v := range tfMap {
+		if err := d.Set(k, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+		}
+	}
+	d.Set("web_acl_arn", webACLARN)
+
+	return diags
+}
+
+func resourceWebACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	name := d.Get(names.AttrName).(string)
+
+	rule := expandWebACLRule(webACLRuleResourceDataMap(d))
+	if err := modifyWebACLRule(ctx, conn, webACLARN, name, &rule, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	name := d.Get(names.AttrName).(string)
+
+	log.Printf("[INFO] Deleting WAFv2 WebACL Rule: %s", d.Id())
+	err := modifyWebACLRule(ctx, conn, webACLARN, name, nil, false, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// webACLRuleResourceDataMap returns the rule attributes of the resource as a map
+// suitable for expandWebACLRule.
+func webACLRuleResourceDataMap(d *schema.ResourceData) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for _, k := range []string{names.AttrAction, "captcha_config", names.AttrName, "override_action", names.AttrPriority, "rule_label", "statement", "visibility_config"} {
+		tfMap[k] = d.Get(k)
+	}
+
+	return tfMap
+}
+
+// webACLMutexKey returns the key used to serialize modifications of a Web ACL's
+// rules between aws_wafv2_web_acl and aws_wafv2_web_acl_rule resources.
+func webACLMutexKey(webACLARN string) string {
+	return "wafv2-web-acl-" + webACLARN
+}
+
+// modifyWebACLRule adds, replaces or (when rule is nil) removes the named rule in
+// the specified Web ACL, leaving all other rules untouched.
+// The whole Web ACL is sent back with UpdateWebACL, so the operation is retried
+// with a fresh lock token if the Web ACL is modified concurrently.
+func modifyWebACLRule(ctx context.Context, conn *wafv2.Client, webACLARN, name string, rule *awstypes.Rule, create bool, timeout time.Duration) error {
+	id, aclName, scope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return err
+	}
+
+	mutexKey := webACLMutexKey(webACLARN)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	_, err = tfresource.RetryWhenIsOneOf2[*awstypes.WAFOptimisticLockException, *awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
+		output, err := findWebACLByThreePartKey(ctx, conn, id, aclName, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		webACL := output.WebACL
+		rules := webACL.Rules
+		idx := slices.IndexFunc(rules, func(v awstypes.Rule) bool {
+			return aws.ToString(v.Name) == name
+		})
+
+		switch {
+		case rule == nil:
+			if idx == -1 {
+				return nil, &retry.NotFoundError{
+					Message: fmt.Sprintf("rule (%s) not found in WAFv2 WebACL (%s)", name, webACLARN),
+				}
+			}
+			rules = slices.Delete(slices.Clone(rules), idx, idx+1)
+		default:
+			if create && idx != -1 {
+				return nil, fmt.Errorf("rule (%s) already exists in WAFv2 WebACL (%s)", name, webACLARN)
+			}
+			if !create && idx == -1 {
+				return nil, &retry.NotFoundError{
+					Message: fmt.Sprintf("rule (%s) not found in WAFv2 WebACL (%s)", name, webACLARN),
+				}
+			}
+			if i := slices.IndexFunc(rules, func(v awstypes.Rule) bool {
+				return v.Priority == rule.Priority && aws.ToString(v.Name) != name
+			}); i != -1 {
+				return nil, fmt.Errorf("priority (%d) is already used by rule (%s) in WAFv2 WebACL (%s)", rule.Priority, aws.ToString(rules[i].Name), webACLARN)
+			}
+
+			rules = slices.Clone(rules)
+			if idx == -1 {
+				rules = append(rules, *rule)
+			} else {
+				rules[idx] = *rule
+			}
+		}
+
+		input := &wafv2.UpdateWebACLInput{
+			AssociationConfig:    webACL.AssociationConfig,
+			CaptchaConfig:        webACL.CaptchaConfig,
+			ChallengeConfig:      webACL.ChallengeConfig,
+			CustomResponseBodies: webACL.CustomResponseBodies,
+			DefaultAction:        webACL.DefaultAction,
+			Description:          webACL.Description,
+			Id:                   webACL.Id,
+			LockToken:            output.LockToken,
+			Name:                 webACL.Name,
+			Rules:                rules,
+			Scope:                awstypes.Scope(scope),
+			TokenDomains:         webACL.TokenDomains,
+			VisibilityConfig:     webACL.VisibilityConfig,
+		}
+
+		return conn.UpdateWebACL(ctx, input)
+	})
+
+	return err
+}
+
+func findWebACLRuleByTwoPartKey(ctx context.Context, conn *wafv2.Client, webACLARN, name string) (*awstypes.Rule, error) {
+	id, aclName, scope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := findWebACLByThreePartKey(ctx, conn, id, aclName, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.WebACL.Rules {
+		if aws.ToString(v.Name) == name {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		Message: fmt.Sprintf("rule (%s) not found in WAFv2 WebACL (%s)", name, webACLARN),
+	}
+}
+
+// parseWebACLARN returns the ID, name and scope of the Web ACL with the specified ARN.
+// Web ACL ARNs have the form arn:${Partition}:wafv2:${Region}:${Account}:${Scope}/webacl/${Name}/${Id},
+// where scope is "regional" or "global" (CloudFront).
+func parseWebACLARN(webACLARN string) (string, string, string, error) {
+	parsedARN, err := arn.Parse(webACLARN)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+	if len(parts) != 4 || parts[1] != "webacl" {
+		return "", "", "", fmt.Errorf("unexpected format for WAFv2 WebACL ARN (%s)", webACLARN)
+	}
+
+	var scope awstypes.Scope
+	switch parts[0] {
+	case "global":
+		scope = awstypes.ScopeCloudfront
+	case "regional":
+		scope = awstypes.ScopeRegional
+	default:
+		return "", "", "", fmt.Errorf("unexpected scope (%s) in WAFv2 WebACL ARN (%s)", parts[0], webACLARN)
+	}
+
+	return parts[3], parts[2], string(scope), nil
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafv2 "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWAFV2WebACLRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test"
+	webACLResourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, "count"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.count.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+					resource.TestCheckResourceAttr(resourceName, "statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", webACLResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(webACLResourceName, "ignore_rules", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, "block"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, "count"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwafv2.ResourceWebACLRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_wafv2_web_acl_rule.test.0"
+	resourceName2 := "aws_wafv2_web_acl_rule.test.1"
+	webACLResourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName1),
+					testAccCheckWebACLRuleExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(webACLResourceName, "rule.#", "0"),
+				),
+			},
+			{
+				// Changing the Web ACL must leave rules managed by aws_wafv2_web_acl_rule untouched.
+				Config: testAccWebACLRuleConfig_multipleUpdatedWebACL(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName1),
+					testAccCheckWebACLRuleExists(ctx, resourceName2),
+					resource.TestCheckResourceAttr(webACLResourceName, names.AttrDescription, "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWebACLRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wafv2_web_acl_rule" {
+				continue
+			}
+
+			_, err := tfwafv2.FindWebACLRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["web_acl_arn"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WAFv2 WebACL Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebACLRuleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
+
+		_, err := tfwafv2.FindWebACLRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["web_acl_arn"], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccWebACLRuleConfig_webACL(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name         = %[1]q
+  description  = %[2]q
+  scope        = "REGIONAL"
+  ignore_rules = true
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, description)
+}
+
+func testAccWebACLRuleConfig_basic(rName, action string) string {
+	return acctest.ConfigCompose(testAccWebACLRuleConfig_webACL(rName, rName), fmt.Sprintf(`
+resource "aws_wafv2_web_acl_rule" "test" {
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+  name        = %[1]q
+  priority    = 10
+
+  action {
+    %[2]s {}
+  }
+
+  statement {
+    geo_match_statement {
+      country_codes = ["US", "NL"]
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-rule-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, action))
+}
+
+func testAccWebACLRuleConfig_multipleRules(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl_rule" "test" {
+  count = 2
+
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+  name        = "%[1]s-${count.index}"
+  priority    = count.index
+
+  action {
+    count {}
+  }
+
+  statement {
+    geo_match_statement {
+      country_codes = ["US"]
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-rule-metric-name-${count.index}"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
+
+func testAccWebACLRuleConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLRuleConfig_webACL(rName, rName), testAccWebACLRuleConfig_multipleRules(rName))
+}
+
+func testAccWebACLRuleConfig_multipleUpdatedWebACL(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLRuleConfig_webACL(rName, "Updated"), testAccWebACLRuleConfig_multipleRules(rName))
+}